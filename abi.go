@@ -0,0 +1,60 @@
+package bigutil
+
+import (
+	"math/big"
+)
+
+// ABIPack encodes the given values as a single Solidity ABI uint256[] value,
+// i.e. the offset of the array, its length and its elements, each as a 32-byte word.
+func ABIPack(is []Uint256) []byte {
+	b := make([]byte, (2+len(is))*maxByteLength)
+
+	big.NewInt(maxByteLength).FillBytes(b[:maxByteLength])
+	new(big.Int).SetUint64(uint64(len(is))).FillBytes(b[maxByteLength : 2*maxByteLength])
+	for idx := range is {
+		offset := (2 + idx) * maxByteLength
+		is[idx].x.FillBytes(b[offset : offset+maxByteLength])
+	}
+
+	return b
+}
+
+// ABIUnpack decodes a single Solidity ABI uint256[] value, e.g. the return data of a function returning uint256[].
+func ABIUnpack(b []byte) ([]Uint256, error) {
+	offset, err := abiReadLength(b, 0)
+	if err != nil {
+		return nil, errorf("invalid offset: %w", err)
+	}
+
+	length, err := abiReadLength(b, offset)
+	if err != nil {
+		return nil, errorf("invalid length: %w", err)
+	}
+
+	start := offset + maxByteLength
+	if uint64(length) > uint64(len(b)-start)/maxByteLength {
+		return nil, errorf("data must contain %d elements", length)
+	}
+
+	is := make([]Uint256, length)
+	for idx := range is {
+		pos := start + idx*maxByteLength
+		is[idx].x.SetBytes(b[pos : pos+maxByteLength])
+	}
+
+	return is, nil
+}
+
+// abiReadLength reads the 32-byte word at the given position as an offset or length that fits in an int.
+func abiReadLength(b []byte, pos int) (int, error) {
+	if pos < 0 || len(b)-pos < maxByteLength {
+		return 0, errorf("data must contain a word at %d", pos)
+	}
+
+	x := new(big.Int).SetBytes(b[pos : pos+maxByteLength])
+	if !x.IsInt64() || x.Int64() > int64(len(b)) {
+		return 0, errorf("must be less than or equal to the data length")
+	}
+
+	return int(x.Int64()), nil
+}
@@ -0,0 +1,132 @@
+package bigutil_test
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+)
+
+func TestABIPack(t *testing.T) {
+	tcs := []struct {
+		name string
+		in   []bigutil.Uint256
+		out  string
+	}{
+		{
+			"empty",
+			nil,
+			word("20") + word("0"),
+		},
+		{
+			"elements",
+			[]bigutil.Uint256{
+				bigutil.Uint64ToUint256(1),
+				bigutil.MaxUint256(),
+			},
+			word("20") + word("2") + word("1") + strings.Repeat("f", 64),
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.out, hex.EncodeToString(bigutil.ABIPack(tc.in)))
+		})
+	}
+}
+
+func TestABIUnpack(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   string
+			out  []bigutil.Uint256
+		}{
+			{
+				"empty",
+				word("20") + word("0"),
+				[]bigutil.Uint256{},
+			},
+			{
+				"elements",
+				word("20") + word("2") + word("1") + strings.Repeat("f", 64),
+				[]bigutil.Uint256{
+					bigutil.Uint64ToUint256(1),
+					bigutil.MaxUint256(),
+				},
+			},
+			{
+				"non-standard offset",
+				word("40") + word("0") + word("1") + word("ff"),
+				uint256s(255),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				b, err := hex.DecodeString(tc.in)
+				require.Nil(t, err)
+
+				is, err := bigutil.ABIUnpack(b)
+				require.Nil(t, err)
+
+				requireUint256sEqual(t, tc.out, is)
+			})
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   string
+		}{
+			{
+				"empty",
+				"",
+			},
+			{
+				"offset out of range",
+				word("40") + word("0"),
+			},
+			{
+				"huge offset",
+				strings.Repeat("f", 64) + word("0"),
+			},
+			{
+				"missing length",
+				word("20"),
+			},
+			{
+				"missing elements",
+				word("20") + word("2") + word("1"),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				b, err := hex.DecodeString(tc.in)
+				require.Nil(t, err)
+
+				_, err = bigutil.ABIUnpack(b)
+				require.Error(t, err)
+			})
+		}
+	})
+}
+
+func TestABIPackUnpack(t *testing.T) {
+	in := uint256s(0, 1, 255, 256)
+
+	out, err := bigutil.ABIUnpack(bigutil.ABIPack(in))
+	require.Nil(t, err)
+
+	requireUint256sEqual(t, in, out)
+}
+
+// word returns the given hex digits left-padded to a 32-byte word.
+func word(s string) string {
+	return strings.Repeat("0", 64-len(s)) + s
+}
@@ -0,0 +1,51 @@
+package bigutil
+
+import (
+	"math/big"
+)
+
+// Accumulator sums Uint256 values while detecting overflow.
+// The zero value is an empty accumulator ready to use.
+// It is not safe for concurrent use.
+type Accumulator struct {
+	sum        big.Int
+	overflowed bool
+}
+
+// Add adds the given value to the total.
+// It returns an error from the call that overflows the total; that value and all values added after it are ignored
+// and do not return the error again until Reset is called.
+func (a *Accumulator) Add(i Uint256) error {
+	if a.overflowed {
+		return nil
+	}
+
+	a.sum.Add(&a.sum, &i.x)
+	if a.sum.BitLen() > maxBitLength {
+		a.sum.Sub(&a.sum, &i.x)
+		a.overflowed = true
+
+		return errorf("total must be less than or equal to %d bits", maxBitLength)
+	}
+
+	return nil
+}
+
+// Total returns the sum of the values added before any overflow.
+func (a *Accumulator) Total() Uint256 {
+	i := Uint256{}
+	i.x.Set(&a.sum)
+
+	return i
+}
+
+// Overflowed reports whether the total has overflowed since the last Reset.
+func (a *Accumulator) Overflowed() bool {
+	return a.overflowed
+}
+
+// Reset resets the accumulator to the zero value.
+func (a *Accumulator) Reset() {
+	a.sum.SetUint64(0)
+	a.overflowed = false
+}
@@ -0,0 +1,39 @@
+package bigutil_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+)
+
+func TestAccumulator(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		var a bigutil.Accumulator
+		for _, i := range uint256s(1, 2, 3) {
+			require.Nil(t, a.Add(i))
+		}
+
+		require.Zero(t, a.Total().BigInt().Cmp(big.NewInt(6)))
+		require.False(t, a.Overflowed())
+	})
+
+	t.Run("overflow", func(t *testing.T) {
+		var a bigutil.Accumulator
+		require.Nil(t, a.Add(bigutil.MaxUint256()))
+		require.Error(t, a.Add(bigutil.Uint64ToUint256(1)))
+		require.Nil(t, a.Add(bigutil.Uint64ToUint256(1)))
+
+		require.Zero(t, a.Total().BigInt().Cmp(bigutil.MaxUint256().BigInt()))
+		require.True(t, a.Overflowed())
+
+		a.Reset()
+
+		require.Zero(t, a.Total().BigInt().Sign())
+		require.False(t, a.Overflowed())
+		require.Nil(t, a.Add(bigutil.Uint64ToUint256(1)))
+		require.Zero(t, a.Total().BigInt().Cmp(big.NewInt(1)))
+	})
+}
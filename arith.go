@@ -0,0 +1,326 @@
+package bigutil
+
+import (
+	"math/big"
+)
+
+var modulus256 = new(big.Int).Lsh(big.NewInt(1), maxBitLength)
+
+// Add returns i + j.
+// It returns an error if the result overflows.
+func (i Uint256) Add(j Uint256) (Uint256, error) {
+	return i.add(&j.x)
+}
+
+// Sub returns i - j.
+// It returns an error if the result underflows.
+func (i Uint256) Sub(j Uint256) (Uint256, error) {
+	return i.sub(&j.x)
+}
+
+// Mul returns i * j.
+// It returns an error if the result overflows.
+func (i Uint256) Mul(j Uint256) (Uint256, error) {
+	return i.mul(&j.x)
+}
+
+// Div returns i / j, truncated towards zero.
+// It returns an error if j is zero.
+func (i Uint256) Div(j Uint256) (Uint256, error) {
+	return i.div(&j.x)
+}
+
+// Mod returns i % j.
+// It returns an error if j is zero.
+func (i Uint256) Mod(j Uint256) (Uint256, error) {
+	return i.mod(&j.x)
+}
+
+// DivMod returns i / j and i % j with a single division.
+// It returns an error if j is zero.
+func (i Uint256) DivMod(j Uint256) (Uint256, Uint256, error) {
+	if j.x.Sign() == 0 {
+		return Uint256{}, Uint256{}, errorf("divisor must not be zero")
+	}
+
+	q, r := Uint256{}, Uint256{}
+	q.x.QuoRem(&i.x, &j.x, &r.x)
+
+	return q, r, nil
+}
+
+// Inc returns i + 1.
+// It returns an error if the result overflows.
+func (i Uint256) Inc() (Uint256, error) {
+	return i.add(big.NewInt(1))
+}
+
+// Dec returns i - 1.
+// It returns an error if the result underflows.
+func (i Uint256) Dec() (Uint256, error) {
+	return i.sub(big.NewInt(1))
+}
+
+// AddBig is like Add, but takes a big.Int operand.
+// It returns an error if x does not represent uint256.
+func (i Uint256) AddBig(x *big.Int) (Uint256, error) {
+	if err := Validate(x); err != nil {
+		return Uint256{}, err
+	}
+
+	return i.add(x)
+}
+
+// SubBig is like Sub, but takes a big.Int operand.
+// It returns an error if x does not represent uint256.
+func (i Uint256) SubBig(x *big.Int) (Uint256, error) {
+	if err := Validate(x); err != nil {
+		return Uint256{}, err
+	}
+
+	return i.sub(x)
+}
+
+// MulBig is like Mul, but takes a big.Int operand.
+// It returns an error if x does not represent uint256.
+func (i Uint256) MulBig(x *big.Int) (Uint256, error) {
+	if err := Validate(x); err != nil {
+		return Uint256{}, err
+	}
+
+	return i.mul(x)
+}
+
+// DivBig is like Div, but takes a big.Int operand.
+// It returns an error if x does not represent uint256.
+func (i Uint256) DivBig(x *big.Int) (Uint256, error) {
+	if err := Validate(x); err != nil {
+		return Uint256{}, err
+	}
+
+	return i.div(x)
+}
+
+// ModBig is like Mod, but takes a big.Int operand.
+// It returns an error if x does not represent uint256.
+func (i Uint256) ModBig(x *big.Int) (Uint256, error) {
+	if err := Validate(x); err != nil {
+		return Uint256{}, err
+	}
+
+	return i.mod(x)
+}
+
+// SaturatingAdd returns i + j, or MaxUint256 if the result overflows.
+func (i Uint256) SaturatingAdd(j Uint256) Uint256 {
+	r, err := i.add(&j.x)
+	if err != nil {
+		return MaxUint256()
+	}
+
+	return r
+}
+
+// SaturatingSub returns i - j, or zero if the result underflows.
+func (i Uint256) SaturatingSub(j Uint256) Uint256 {
+	r, err := i.sub(&j.x)
+	if err != nil {
+		return Uint256{}
+	}
+
+	return r
+}
+
+// SaturatingMul returns i * j, or MaxUint256 if the result overflows.
+func (i Uint256) SaturatingMul(j Uint256) Uint256 {
+	r, err := i.mul(&j.x)
+	if err != nil {
+		return MaxUint256()
+	}
+
+	return r
+}
+
+// WrappingAdd returns (i + j) mod 2^256, as in Solidity unchecked blocks.
+func (i Uint256) WrappingAdd(j Uint256) Uint256 {
+	r := Uint256{}
+	r.x.Add(&i.x, &j.x)
+
+	r.truncate()
+
+	return r
+}
+
+// WrappingSub returns (i - j) mod 2^256, as in Solidity unchecked blocks.
+func (i Uint256) WrappingSub(j Uint256) Uint256 {
+	r := Uint256{}
+	r.x.Sub(&i.x, &j.x)
+
+	r.truncate()
+
+	return r
+}
+
+// WrappingMul returns (i * j) mod 2^256, as in Solidity unchecked blocks.
+func (i Uint256) WrappingMul(j Uint256) Uint256 {
+	r := Uint256{}
+	r.x.Mul(&i.x, &j.x)
+
+	r.truncate()
+
+	return r
+}
+
+// Pow returns i^exp.
+// It returns an error if the result overflows.
+func (i Uint256) Pow(exp Uint256) (Uint256, error) {
+	r := Uint256{}
+
+	// Any base other than 0 and 1 overflows at an exponent of 256, so the big.Int is kept small.
+	if i.x.BitLen() > 1 && exp.x.Cmp(big.NewInt(maxBitLength)) >= 0 {
+		return Uint256{}, errorf("result must be less than or equal to %d bits", maxBitLength)
+	}
+
+	r.x.Exp(&i.x, &exp.x, nil)
+	if r.x.BitLen() > maxBitLength {
+		return Uint256{}, errorf("result must be less than or equal to %d bits", maxBitLength)
+	}
+
+	return r, nil
+}
+
+// WrappingPow returns i^exp mod 2^256, as in Solidity unchecked blocks.
+func (i Uint256) WrappingPow(exp Uint256) Uint256 {
+	r := Uint256{}
+	r.x.Exp(&i.x, &exp.x, modulus256)
+
+	return r
+}
+
+// FullMul returns the 512-bit product i * j as its high and low 256-bit halves.
+// hi is zero if and only if Mul would not overflow.
+func (i Uint256) FullMul(j Uint256) (hi, lo Uint256) {
+	var p big.Int
+	p.Mul(&i.x, &j.x)
+
+	hi.x.Rsh(&p, maxBitLength)
+	lo.x.And(&p, maxBig256)
+
+	return hi, lo
+}
+
+// Dist returns |i - j|.
+func (i Uint256) Dist(j Uint256) Uint256 {
+	r := Uint256{}
+	r.x.Sub(&i.x, &j.x)
+	r.x.Abs(&r.x)
+
+	return r
+}
+
+// Avg returns floor((i + j) / 2), the midpoint of i and j rounded down.
+// It is computed as (i & j) + ((i ^ j) >> 1), so the intermediate sum never overflows.
+func (i Uint256) Avg(j Uint256) Uint256 {
+	var x big.Int
+	x.Xor(&i.x, &j.x)
+	x.Rsh(&x, 1)
+
+	r := Uint256{}
+	r.x.And(&i.x, &j.x)
+	r.x.Add(&r.x, &x)
+
+	return r
+}
+
+// Sqrt returns floor(sqrt(i)).
+func (i Uint256) Sqrt() Uint256 {
+	r := Uint256{}
+	r.x.Sqrt(&i.x)
+
+	return r
+}
+
+// RoundUpToMultiple returns the smallest multiple of m greater than or equal to i.
+// It returns an error if m is zero or the result overflows.
+func (i Uint256) RoundUpToMultiple(m Uint256) (Uint256, error) {
+	r, err := i.RoundDownToMultiple(m)
+	if err != nil {
+		return Uint256{}, err
+	}
+	if r.x.Cmp(&i.x) == 0 {
+		return r, nil
+	}
+
+	return r.Add(m)
+}
+
+// RoundDownToMultiple returns the largest multiple of m less than or equal to i.
+// It returns an error if m is zero.
+func (i Uint256) RoundDownToMultiple(m Uint256) (Uint256, error) {
+	r, err := i.Mod(m)
+	if err != nil {
+		return Uint256{}, err
+	}
+
+	r.x.Sub(&i.x, &r.x)
+
+	return r, nil
+}
+
+// truncate reduces i modulo 2^256 in place, taking negative values as two's complement.
+func (i *Uint256) truncate() {
+	i.x.And(&i.x, maxBig256)
+}
+
+func (i Uint256) add(y *big.Int) (Uint256, error) {
+	r := Uint256{}
+	r.x.Add(&i.x, y)
+	if r.x.BitLen() > maxBitLength {
+		return Uint256{}, errorf("result must be less than or equal to %d bits", maxBitLength)
+	}
+
+	return r, nil
+}
+
+func (i Uint256) sub(y *big.Int) (Uint256, error) {
+	if i.x.Cmp(y) < 0 {
+		return Uint256{}, errorf("result must be positive")
+	}
+
+	r := Uint256{}
+	r.x.Sub(&i.x, y)
+
+	return r, nil
+}
+
+func (i Uint256) mul(y *big.Int) (Uint256, error) {
+	r := Uint256{}
+	r.x.Mul(&i.x, y)
+	if r.x.BitLen() > maxBitLength {
+		return Uint256{}, errorf("result must be less than or equal to %d bits", maxBitLength)
+	}
+
+	return r, nil
+}
+
+func (i Uint256) div(y *big.Int) (Uint256, error) {
+	if y.Sign() == 0 {
+		return Uint256{}, errorf("divisor must not be zero")
+	}
+
+	r := Uint256{}
+	r.x.Quo(&i.x, y)
+
+	return r, nil
+}
+
+func (i Uint256) mod(y *big.Int) (Uint256, error) {
+	if y.Sign() == 0 {
+		return Uint256{}, errorf("divisor must not be zero")
+	}
+
+	r := Uint256{}
+	r.x.Rem(&i.x, y)
+
+	return r, nil
+}
@@ -0,0 +1,756 @@
+package bigutil_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+)
+
+type arithOp struct {
+	name string
+	fn   func(i, j bigutil.Uint256) (bigutil.Uint256, error)
+	big  func(i bigutil.Uint256, x *big.Int) (bigutil.Uint256, error)
+}
+
+var (
+	addOp = arithOp{"add", bigutil.Uint256.Add, bigutil.Uint256.AddBig}
+	subOp = arithOp{"sub", bigutil.Uint256.Sub, bigutil.Uint256.SubBig}
+	mulOp = arithOp{"mul", bigutil.Uint256.Mul, bigutil.Uint256.MulBig}
+	divOp = arithOp{"div", bigutil.Uint256.Div, bigutil.Uint256.DivBig}
+	modOp = arithOp{"mod", bigutil.Uint256.Mod, bigutil.Uint256.ModBig}
+)
+
+func TestUint256Arithmetic(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			op   arithOp
+			i    bigutil.Uint256
+			j    bigutil.Uint256
+			out  bigutil.Uint256
+		}{
+			{
+				"add",
+				addOp,
+				bigutil.Uint64ToUint256(1),
+				bigutil.Uint64ToUint256(2),
+				bigutil.Uint64ToUint256(3),
+			},
+			{
+				"add: max",
+				addOp,
+				bigutil.MustHexToUint256("0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe"),
+				bigutil.One(),
+				bigutil.MaxUint256(),
+			},
+			{
+				"sub: zero",
+				subOp,
+				bigutil.MaxUint256(),
+				bigutil.MaxUint256(),
+				bigutil.Zero(),
+			},
+			{
+				"mul",
+				mulOp,
+				bigutil.Uint64ToUint256(1 << 63),
+				bigutil.Uint64ToUint256(4),
+				bigutil.MustHexToUint256("0x20000000000000000"),
+			},
+			{
+				"div: truncated",
+				divOp,
+				bigutil.Uint64ToUint256(7),
+				bigutil.Uint64ToUint256(2),
+				bigutil.Uint64ToUint256(3),
+			},
+			{
+				"mod",
+				modOp,
+				bigutil.Uint64ToUint256(7),
+				bigutil.Uint64ToUint256(2),
+				bigutil.One(),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				out, err := tc.op.fn(tc.i, tc.j)
+				require.Nil(t, err)
+				require.Zero(t, out.BigInt().Cmp(tc.out.BigInt()))
+
+				out, err = tc.op.big(tc.i, tc.j.BigInt())
+				require.Nil(t, err)
+				require.Zero(t, out.BigInt().Cmp(tc.out.BigInt()))
+			})
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			op   arithOp
+			i    bigutil.Uint256
+			j    bigutil.Uint256
+		}{
+			{
+				"add: overflow",
+				addOp,
+				bigutil.MaxUint256(),
+				bigutil.One(),
+			},
+			{
+				"sub: underflow",
+				subOp,
+				bigutil.Zero(),
+				bigutil.One(),
+			},
+			{
+				"mul: overflow",
+				mulOp,
+				bigutil.MaxUint256(),
+				bigutil.Uint64ToUint256(2),
+			},
+			{
+				"div: by zero",
+				divOp,
+				bigutil.One(),
+				bigutil.Zero(),
+			},
+			{
+				"mod: by zero",
+				modOp,
+				bigutil.One(),
+				bigutil.Zero(),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := tc.op.fn(tc.i, tc.j)
+				require.Error(t, err)
+
+				_, err = tc.op.big(tc.i, tc.j.BigInt())
+				require.Error(t, err)
+			})
+		}
+
+		t.Run("invalid operand", func(t *testing.T) {
+			for _, op := range []arithOp{addOp, subOp, mulOp, divOp, modOp} {
+				t.Run(op.name, func(t *testing.T) {
+					_, err := op.big(bigutil.One(), big.NewInt(-1))
+					require.Error(t, err)
+
+					_, err = op.big(bigutil.One(), nil)
+					require.Error(t, err)
+				})
+			}
+		})
+	})
+}
+
+func TestUint256DivMod(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			i    bigutil.Uint256
+			j    bigutil.Uint256
+			q    bigutil.Uint256
+			r    bigutil.Uint256
+		}{
+			{
+				"small",
+				bigutil.Uint64ToUint256(17),
+				bigutil.Uint64ToUint256(5),
+				bigutil.Uint64ToUint256(3),
+				bigutil.Uint64ToUint256(2),
+			},
+			{
+				"exact",
+				bigutil.MaxUint256(),
+				bigutil.MaxUint256(),
+				bigutil.One(),
+				bigutil.Zero(),
+			},
+			{
+				"dividend less than divisor",
+				bigutil.Uint64ToUint256(3),
+				bigutil.MaxUint256(),
+				bigutil.Zero(),
+				bigutil.Uint64ToUint256(3),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				q, r, err := tc.i.DivMod(tc.j)
+				require.Nil(t, err)
+
+				require.Zero(t, q.BigInt().Cmp(tc.q.BigInt()))
+				require.Zero(t, r.BigInt().Cmp(tc.r.BigInt()))
+			})
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		_, _, err := bigutil.One().DivMod(bigutil.Zero())
+		require.Error(t, err)
+	})
+}
+
+func TestUint256IncDec(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			fn   func(i bigutil.Uint256) (bigutil.Uint256, error)
+			in   bigutil.Uint256
+			out  bigutil.Uint256
+		}{
+			{
+				"inc",
+				bigutil.Uint256.Inc,
+				bigutil.Zero(),
+				bigutil.One(),
+			},
+			{
+				"inc: to max",
+				bigutil.Uint256.Inc,
+				bigutil.MustHexToUint256("0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe"),
+				bigutil.MaxUint256(),
+			},
+			{
+				"dec",
+				bigutil.Uint256.Dec,
+				bigutil.One(),
+				bigutil.Zero(),
+			},
+			{
+				"dec: from max",
+				bigutil.Uint256.Dec,
+				bigutil.MaxUint256(),
+				bigutil.MustHexToUint256("0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe"),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				out, err := tc.fn(tc.in)
+				require.Nil(t, err)
+
+				require.Zero(t, out.BigInt().Cmp(tc.out.BigInt()))
+			})
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		_, err := bigutil.MaxUint256().Inc()
+		require.Error(t, err)
+
+		_, err = bigutil.Zero().Dec()
+		require.Error(t, err)
+	})
+}
+
+func TestUint256AddNoAliasing(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		i := bigutil.Uint64ToUint256(1)
+
+		out, err := i.Add(bigutil.One())
+		require.Nil(t, err)
+		out.SetUint64(10)
+
+		require.True(t, i.EqualUint64(1))
+	})
+}
+
+func TestUint256Saturating(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			fn   func(i, j bigutil.Uint256) bigutil.Uint256
+			i    bigutil.Uint256
+			j    bigutil.Uint256
+			out  bigutil.Uint256
+		}{
+			{
+				"add",
+				bigutil.Uint256.SaturatingAdd,
+				bigutil.One(),
+				bigutil.One(),
+				bigutil.Uint64ToUint256(2),
+			},
+			{
+				"add: overflow",
+				bigutil.Uint256.SaturatingAdd,
+				bigutil.MaxUint256(),
+				bigutil.One(),
+				bigutil.MaxUint256(),
+			},
+			{
+				"sub",
+				bigutil.Uint256.SaturatingSub,
+				bigutil.Uint64ToUint256(2),
+				bigutil.One(),
+				bigutil.One(),
+			},
+			{
+				"sub: underflow",
+				bigutil.Uint256.SaturatingSub,
+				bigutil.One(),
+				bigutil.Uint64ToUint256(2),
+				bigutil.Zero(),
+			},
+			{
+				"mul",
+				bigutil.Uint256.SaturatingMul,
+				bigutil.Uint64ToUint256(2),
+				bigutil.Uint64ToUint256(3),
+				bigutil.Uint64ToUint256(6),
+			},
+			{
+				"mul: overflow",
+				bigutil.Uint256.SaturatingMul,
+				bigutil.MaxUint256(),
+				bigutil.Uint64ToUint256(2),
+				bigutil.MaxUint256(),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Zero(t, tc.fn(tc.i, tc.j).BigInt().Cmp(tc.out.BigInt()))
+			})
+		}
+	})
+}
+
+func TestUint256Wrapping(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			fn   func(i, j bigutil.Uint256) bigutil.Uint256
+			i    bigutil.Uint256
+			j    bigutil.Uint256
+			out  bigutil.Uint256
+		}{
+			{
+				"add",
+				bigutil.Uint256.WrappingAdd,
+				bigutil.One(),
+				bigutil.One(),
+				bigutil.Uint64ToUint256(2),
+			},
+			{
+				"add: overflow",
+				bigutil.Uint256.WrappingAdd,
+				bigutil.MaxUint256(),
+				bigutil.Uint64ToUint256(2),
+				bigutil.One(),
+			},
+			{
+				"sub",
+				bigutil.Uint256.WrappingSub,
+				bigutil.Uint64ToUint256(2),
+				bigutil.One(),
+				bigutil.One(),
+			},
+			{
+				"sub: underflow",
+				bigutil.Uint256.WrappingSub,
+				bigutil.Zero(),
+				bigutil.One(),
+				bigutil.MaxUint256(),
+			},
+			{
+				"mul",
+				bigutil.Uint256.WrappingMul,
+				bigutil.Uint64ToUint256(2),
+				bigutil.Uint64ToUint256(3),
+				bigutil.Uint64ToUint256(6),
+			},
+			{
+				"mul: overflow",
+				bigutil.Uint256.WrappingMul,
+				bigutil.MaxUint256(),
+				bigutil.MaxUint256(),
+				bigutil.One(),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Zero(t, tc.fn(tc.i, tc.j).BigInt().Cmp(tc.out.BigInt()))
+			})
+		}
+	})
+}
+
+func TestUint256FullMul(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			i    bigutil.Uint256
+			j    bigutil.Uint256
+			hi   bigutil.Uint256
+			lo   bigutil.Uint256
+		}{
+			{
+				"small",
+				bigutil.Uint64ToUint256(6),
+				bigutil.Uint64ToUint256(7),
+				bigutil.Zero(),
+				bigutil.Uint64ToUint256(42),
+			},
+			{
+				"carry",
+				bigutil.MaxUint256(),
+				bigutil.Uint64ToUint256(2),
+				bigutil.One(),
+				bigutil.MustHexToUint256("0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe"),
+			},
+			{
+				"max",
+				bigutil.MaxUint256(),
+				bigutil.MaxUint256(),
+				bigutil.MustHexToUint256("0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe"),
+				bigutil.One(),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				hi, lo := tc.i.FullMul(tc.j)
+				require.Zero(t, hi.BigInt().Cmp(tc.hi.BigInt()))
+				require.Zero(t, lo.BigInt().Cmp(tc.lo.BigInt()))
+			})
+		}
+	})
+}
+
+func TestUint256Dist(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			i    bigutil.Uint256
+			j    bigutil.Uint256
+			out  bigutil.Uint256
+		}{
+			{
+				"greater",
+				bigutil.Uint64ToUint256(7),
+				bigutil.Uint64ToUint256(3),
+				bigutil.Uint64ToUint256(4),
+			},
+			{
+				"less",
+				bigutil.Uint64ToUint256(3),
+				bigutil.Uint64ToUint256(7),
+				bigutil.Uint64ToUint256(4),
+			},
+			{
+				"equal",
+				bigutil.MaxUint256(),
+				bigutil.MaxUint256(),
+				bigutil.Zero(),
+			},
+			{
+				"max",
+				bigutil.Zero(),
+				bigutil.MaxUint256(),
+				bigutil.MaxUint256(),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Zero(t, tc.i.Dist(tc.j).BigInt().Cmp(tc.out.BigInt()))
+			})
+		}
+	})
+}
+
+func TestUint256Avg(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			i    bigutil.Uint256
+			j    bigutil.Uint256
+			out  bigutil.Uint256
+		}{
+			{
+				"even",
+				bigutil.Uint64ToUint256(4),
+				bigutil.Uint64ToUint256(8),
+				bigutil.Uint64ToUint256(6),
+			},
+			{
+				"odd",
+				bigutil.Uint64ToUint256(4),
+				bigutil.Uint64ToUint256(7),
+				bigutil.Uint64ToUint256(5),
+			},
+			{
+				"near max",
+				bigutil.MaxUint256(),
+				bigutil.MustHexToUint256("0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffd"),
+				bigutil.MustHexToUint256("0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe"),
+			},
+			{
+				"max",
+				bigutil.MaxUint256(),
+				bigutil.MaxUint256(),
+				bigutil.MaxUint256(),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Zero(t, tc.i.Avg(tc.j).BigInt().Cmp(tc.out.BigInt()))
+				require.Zero(t, tc.j.Avg(tc.i).BigInt().Cmp(tc.out.BigInt()))
+			})
+		}
+	})
+}
+
+func TestUint256Sqrt(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   bigutil.Uint256
+			out  bigutil.Uint256
+		}{
+			{
+				"zero",
+				bigutil.Zero(),
+				bigutil.Zero(),
+			},
+			{
+				"perfect square",
+				bigutil.Uint64ToUint256(144),
+				bigutil.Uint64ToUint256(12),
+			},
+			{
+				"floor",
+				bigutil.Uint64ToUint256(143),
+				bigutil.Uint64ToUint256(11),
+			},
+			{
+				"max",
+				bigutil.MaxUint256(),
+				bigutil.MustHexToUint256("0xffffffffffffffffffffffffffffffff"),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Zero(t, tc.in.Sqrt().BigInt().Cmp(tc.out.BigInt()))
+			})
+		}
+	})
+}
+
+func TestUint256Pow(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   bigutil.Uint256
+			exp  bigutil.Uint256
+			out  bigutil.Uint256
+		}{
+			{
+				"decimals",
+				bigutil.Uint64ToUint256(10),
+				bigutil.Uint64ToUint256(18),
+				bigutil.Uint64ToUint256(1_000_000_000_000_000_000),
+			},
+			{
+				"zero exponent",
+				bigutil.Zero(),
+				bigutil.Zero(),
+				bigutil.One(),
+			},
+			{
+				"zero base",
+				bigutil.Zero(),
+				bigutil.MaxUint256(),
+				bigutil.Zero(),
+			},
+			{
+				"one base",
+				bigutil.One(),
+				bigutil.MaxUint256(),
+				bigutil.One(),
+			},
+			{
+				"largest",
+				bigutil.Uint64ToUint256(2),
+				bigutil.Uint64ToUint256(255),
+				bigutil.MustHexToUint256("0x8000000000000000000000000000000000000000000000000000000000000000"),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				out, err := tc.in.Pow(tc.exp)
+				require.Nil(t, err)
+
+				require.Zero(t, out.BigInt().Cmp(tc.out.BigInt()))
+			})
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   bigutil.Uint256
+			exp  bigutil.Uint256
+		}{
+			{
+				"overflow",
+				bigutil.Uint64ToUint256(10),
+				bigutil.Uint64ToUint256(78),
+			},
+			{
+				"large exponent",
+				bigutil.Uint64ToUint256(2),
+				bigutil.MaxUint256(),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := tc.in.Pow(tc.exp)
+				require.Error(t, err)
+			})
+		}
+	})
+}
+
+func TestUint256WrappingPow(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   bigutil.Uint256
+			exp  bigutil.Uint256
+			out  bigutil.Uint256
+		}{
+			{
+				"decimals",
+				bigutil.Uint64ToUint256(10),
+				bigutil.Uint64ToUint256(18),
+				bigutil.Uint64ToUint256(1_000_000_000_000_000_000),
+			},
+			{
+				"overflow",
+				bigutil.Uint64ToUint256(2),
+				bigutil.Uint64ToUint256(256),
+				bigutil.Zero(),
+			},
+			{
+				"max",
+				bigutil.MaxUint256(),
+				bigutil.Uint64ToUint256(3),
+				bigutil.MaxUint256(),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Zero(t, tc.in.WrappingPow(tc.exp).BigInt().Cmp(tc.out.BigInt()))
+			})
+		}
+	})
+}
+
+func TestUint256RoundToMultiple(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   bigutil.Uint256
+			m    bigutil.Uint256
+			up   bigutil.Uint256
+			down bigutil.Uint256
+		}{
+			{
+				"aligned",
+				bigutil.Uint64ToUint256(300),
+				bigutil.Uint64ToUint256(100),
+				bigutil.Uint64ToUint256(300),
+				bigutil.Uint64ToUint256(300),
+			},
+			{
+				"unaligned",
+				bigutil.Uint64ToUint256(301),
+				bigutil.Uint64ToUint256(100),
+				bigutil.Uint64ToUint256(400),
+				bigutil.Uint64ToUint256(300),
+			},
+			{
+				"zero",
+				bigutil.Zero(),
+				bigutil.Uint64ToUint256(100),
+				bigutil.Zero(),
+				bigutil.Zero(),
+			},
+			{
+				"max",
+				bigutil.MaxUint256(),
+				bigutil.MaxUint256(),
+				bigutil.MaxUint256(),
+				bigutil.MaxUint256(),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				up, err := tc.in.RoundUpToMultiple(tc.m)
+				require.Nil(t, err)
+
+				require.Zero(t, up.BigInt().Cmp(tc.up.BigInt()))
+
+				down, err := tc.in.RoundDownToMultiple(tc.m)
+				require.Nil(t, err)
+
+				require.Zero(t, down.BigInt().Cmp(tc.down.BigInt()))
+			})
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			fn   func(i, m bigutil.Uint256) (bigutil.Uint256, error)
+			in   bigutil.Uint256
+			m    bigutil.Uint256
+		}{
+			{
+				"up: zero multiple",
+				bigutil.Uint256.RoundUpToMultiple,
+				bigutil.One(),
+				bigutil.Zero(),
+			},
+			{
+				"up: overflow",
+				bigutil.Uint256.RoundUpToMultiple,
+				bigutil.MaxUint256(),
+				bigutil.Uint64ToUint256(2),
+			},
+			{
+				"down: zero multiple",
+				bigutil.Uint256.RoundDownToMultiple,
+				bigutil.One(),
+				bigutil.Zero(),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := tc.fn(tc.in, tc.m)
+				require.Error(t, err)
+			})
+		}
+	})
+}
@@ -0,0 +1,51 @@
+package bigutil
+
+// AddAssign sets i to i + j, reusing the storage of i.
+// i is left unchanged if the result overflows.
+//
+// Unlike Add, it does not allocate a new result, which matters in hot loops.
+// Since i is modified in place, a Uint256 sharing storage with i (e.g. obtained by plain assignment) may be corrupted;
+// clone such values beforehand. j itself may share storage with i, as in i.AddAssign(*i).
+func (i *Uint256) AddAssign(j Uint256) error {
+	// The sum of two values shorter than 256 bits cannot overflow.
+	if i.x.BitLen() == maxBitLength || j.x.BitLen() == maxBitLength {
+		if _, err := i.add(&j.x); err != nil {
+			return err
+		}
+	}
+
+	i.x.Add(&i.x, &j.x)
+
+	return nil
+}
+
+// SubAssign sets i to i - j, reusing the storage of i.
+// i is left unchanged if the result underflows.
+//
+// The aliasing rules are the same as those of AddAssign.
+func (i *Uint256) SubAssign(j Uint256) error {
+	if i.x.Cmp(&j.x) < 0 {
+		return errorf("result must be positive")
+	}
+
+	i.x.Sub(&i.x, &j.x)
+
+	return nil
+}
+
+// MulAssign sets i to i * j, reusing the storage of i.
+// i is left unchanged if the result overflows.
+//
+// The aliasing rules are the same as those of AddAssign.
+func (i *Uint256) MulAssign(j Uint256) error {
+	// The product of an n-bit and an m-bit value is n+m-1 or n+m bits long.
+	if n := i.x.BitLen() + j.x.BitLen(); n > maxBitLength {
+		if _, err := i.mul(&j.x); err != nil {
+			return err
+		}
+	}
+
+	i.x.Mul(&i.x, &j.x)
+
+	return nil
+}
@@ -0,0 +1,146 @@
+package bigutil_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+)
+
+func TestUint256Assign(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			fn   func(i *bigutil.Uint256, j bigutil.Uint256) error
+			i    bigutil.Uint256
+			j    bigutil.Uint256
+			out  bigutil.Uint256
+		}{
+			{
+				"add",
+				(*bigutil.Uint256).AddAssign,
+				bigutil.One(),
+				bigutil.Uint64ToUint256(2),
+				bigutil.Uint64ToUint256(3),
+			},
+			{
+				"add: to max",
+				(*bigutil.Uint256).AddAssign,
+				bigutil.MustHexToUint256("0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe"),
+				bigutil.One(),
+				bigutil.MaxUint256(),
+			},
+			{
+				"add: max and zero",
+				(*bigutil.Uint256).AddAssign,
+				bigutil.MaxUint256(),
+				bigutil.Zero(),
+				bigutil.MaxUint256(),
+			},
+			{
+				"sub",
+				(*bigutil.Uint256).SubAssign,
+				bigutil.Uint64ToUint256(3),
+				bigutil.Uint64ToUint256(2),
+				bigutil.One(),
+			},
+			{
+				"sub: to zero",
+				(*bigutil.Uint256).SubAssign,
+				bigutil.MaxUint256(),
+				bigutil.MaxUint256(),
+				bigutil.Zero(),
+			},
+			{
+				"mul",
+				(*bigutil.Uint256).MulAssign,
+				bigutil.Uint64ToUint256(2),
+				bigutil.Uint64ToUint256(3),
+				bigutil.Uint64ToUint256(6),
+			},
+			{
+				"mul: to max",
+				(*bigutil.Uint256).MulAssign,
+				bigutil.MaxUint256(),
+				bigutil.One(),
+				bigutil.MaxUint256(),
+			},
+			{
+				"mul: to largest power of two",
+				(*bigutil.Uint256).MulAssign,
+				bigutil.MustHexToUint256("0x100000000000000000000000000000000"),
+				bigutil.MustHexToUint256("0x80000000000000000000000000000000"),
+				bigutil.MustHexToUint256("0x8000000000000000000000000000000000000000000000000000000000000000"),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Nil(t, tc.fn(&tc.i, tc.j))
+
+				require.Zero(t, tc.i.BigInt().Cmp(tc.out.BigInt()))
+			})
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			fn   func(i *bigutil.Uint256, j bigutil.Uint256) error
+			i    bigutil.Uint256
+			j    bigutil.Uint256
+		}{
+			{
+				"add: overflow",
+				(*bigutil.Uint256).AddAssign,
+				bigutil.MaxUint256(),
+				bigutil.One(),
+			},
+			{
+				"sub: underflow",
+				(*bigutil.Uint256).SubAssign,
+				bigutil.Zero(),
+				bigutil.One(),
+			},
+			{
+				"mul: overflow",
+				(*bigutil.Uint256).MulAssign,
+				bigutil.MustHexToUint256("0x100000000000000000000000000000000"),
+				bigutil.MustHexToUint256("0x100000000000000000000000000000000"),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				in := tc.i.Clone()
+				require.Error(t, tc.fn(&tc.i, tc.j))
+
+				require.Zero(t, tc.i.BigInt().Cmp(in.BigInt()))
+			})
+		}
+	})
+
+	t.Run("aliasing", func(t *testing.T) {
+		i := bigutil.Uint64ToUint256(3)
+		require.Nil(t, i.AddAssign(i))
+		require.True(t, i.EqualUint64(6))
+
+		require.Nil(t, i.MulAssign(i))
+		require.True(t, i.EqualUint64(36))
+
+		require.Nil(t, i.SubAssign(i))
+		require.True(t, i.IsZero())
+	})
+
+	t.Run("no allocation", func(t *testing.T) {
+		i, j := bigutil.Zero(), bigutil.One()
+		require.Nil(t, i.AddAssign(j))
+
+		require.Zero(t, testing.AllocsPerRun(100, func() {
+			_ = i.AddAssign(j)
+			_ = i.SubAssign(j)
+			_ = i.MulAssign(j)
+		}))
+	})
+}
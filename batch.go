@@ -0,0 +1,157 @@
+package bigutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"math/bits"
+)
+
+const (
+	wordByteLength   = bits.UintSize / 8
+	wordsPerUint256  = maxByteLength / wordByteLength
+	arenaChunkLength = 64
+)
+
+// IndexError records the index of the element that caused a batch operation to fail.
+type IndexError struct {
+	Index int
+	Err   error
+}
+
+// Error implements the error interface.
+func (e *IndexError) Error() string {
+	return fmt.Sprintf("index %d: %s", e.Index, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *IndexError) Unwrap() error {
+	return e.Err
+}
+
+// ParseHexBatch converts the given hex strings to Uint256s.
+// The storage for all results is allocated at once.
+// If an element is invalid, it returns an *IndexError holding the index of the first invalid element.
+func ParseHexBatch(ss []string) ([]Uint256, error) {
+	is := make([]Uint256, len(ss))
+	arena := wordArena{chunkLength: len(ss)}
+
+	for idx, s := range ss {
+		b, err := decodeHexBytes(s, false)
+		if err != nil {
+			return nil, &IndexError{idx, err}
+		}
+
+		is[idx].setBytes32(arena.next(), &b)
+	}
+
+	return is, nil
+}
+
+// DecodeJSONArray decodes a JSON array of Uint256s from the given json.Decoder.
+// The storage for the results is allocated in chunks rather than per element.
+// If an element is invalid, it returns an *IndexError holding the index of the first invalid element.
+func DecodeJSONArray(dec *json.Decoder) ([]Uint256, error) {
+	var is []Uint256
+
+	if err := DecodeUint256Array(dec, func(i Uint256) error {
+		is = append(is, i)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return is, nil
+}
+
+// DecodeUint256Array decodes a JSON array of Uint256s from the given json.Decoder element by element,
+// calling fn for each of them, so that the whole array is never buffered.
+// If an element is invalid or fn returns an error, it stops and returns an *IndexError holding the index of the element.
+func DecodeUint256Array(dec *json.Decoder, fn func(Uint256) error) error {
+	c := DefaultCodec()
+	arena := wordArena{chunkLength: arenaChunkLength}
+
+	if err := expectJSONDelim(dec, '['); err != nil {
+		return err
+	}
+
+	var raw json.RawMessage
+	for idx := 0; dec.More(); idx++ {
+		if err := dec.Decode(&raw); err != nil {
+			return &IndexError{idx, err}
+		}
+
+		var i Uint256
+		if err := i.unmarshalJSONWithArena(raw, c, &arena); err != nil {
+			return &IndexError{idx, err}
+		}
+
+		if err := fn(i); err != nil {
+			return &IndexError{idx, err}
+		}
+	}
+
+	return expectJSONDelim(dec, ']')
+}
+
+// unmarshalJSONWithArena is like unmarshalJSON, but uses storage from arena for hex strings
+// when c has no option that restricts parsing; all other input goes through unmarshalJSON as is.
+func (i *Uint256) unmarshalJSONWithArena(b []byte, c Codec, arena *wordArena) error {
+	text, _ := unquote(b)
+
+	if !c.StrictParsing && c.AcceptedFormats == AcceptAny && isHexText(text) {
+		b32, err := decodeHexBytes(text, true)
+		if err != nil {
+			return err
+		}
+
+		i.setBytes32(arena.next(), &b32)
+
+		return nil
+	}
+
+	return i.unmarshalJSON(b, c)
+}
+
+// setBytes32 sets i to the value of the given big-endian 32-byte representation,
+// using words as the storage.
+func (i *Uint256) setBytes32(words []big.Word, b *[maxByteLength]byte) {
+	for idx := range words {
+		var w big.Word
+		for k := 0; k < wordByteLength; k++ {
+			w |= big.Word(b[maxByteLength-1-idx*wordByteLength-k]) << (8 * k)
+		}
+		words[idx] = w
+	}
+
+	i.x.SetBits(words)
+}
+
+// wordArena hands out fixed-size word slices carved from larger allocations.
+type wordArena struct {
+	buf         []big.Word
+	chunkLength int
+}
+
+func (a *wordArena) next() []big.Word {
+	if len(a.buf) < wordsPerUint256 {
+		a.buf = make([]big.Word, max(a.chunkLength, 1)*wordsPerUint256)
+	}
+
+	words := a.buf[:wordsPerUint256:wordsPerUint256]
+	a.buf = a.buf[wordsPerUint256:]
+
+	return words
+}
+
+func expectJSONDelim(dec *json.Decoder, delim json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if d, ok := tok.(json.Delim); !ok || d != delim {
+		return errorf("unexpected token: %v", tok)
+	}
+
+	return nil
+}
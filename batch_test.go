@@ -0,0 +1,189 @@
+package bigutil_test
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+)
+
+func TestParseHexBatch(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []string
+			out  []bigutil.Uint256
+		}{
+			{
+				"empty",
+				[]string{},
+				[]bigutil.Uint256{},
+			},
+			{
+				"min and max",
+				[]string{"0x0", "0x1", "0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"},
+				[]bigutil.Uint256{
+					bigutil.Uint64ToUint256(0),
+					bigutil.Uint64ToUint256(1),
+					bigutil.MaxUint256(),
+				},
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				is, err := bigutil.ParseHexBatch(tc.in)
+				require.Nil(t, err)
+
+				require.Len(t, is, len(tc.out))
+				for idx := range is {
+					require.Zero(t, is[idx].BigInt().Cmp(tc.out[idx].BigInt()))
+				}
+			})
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		_, err := bigutil.ParseHexBatch([]string{"0x0", "0x1", "0xg", "0x"})
+		require.Error(t, err)
+
+		var idxErr *bigutil.IndexError
+		require.True(t, errors.As(err, &idxErr))
+		require.Equal(t, 2, idxErr.Index)
+	})
+}
+
+func TestDecodeJSONArray(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   string
+			out  []bigutil.Uint256
+		}{
+			{
+				"empty",
+				`[]`,
+				nil,
+			},
+			{
+				"mixed",
+				`["0x0", "0x0001", "10", 11, "0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"]`,
+				[]bigutil.Uint256{
+					bigutil.Uint64ToUint256(0),
+					bigutil.Uint64ToUint256(1),
+					bigutil.Uint64ToUint256(10),
+					bigutil.Uint64ToUint256(11),
+					bigutil.MaxUint256(),
+				},
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				is, err := bigutil.DecodeJSONArray(json.NewDecoder(strings.NewReader(tc.in)))
+				require.Nil(t, err)
+
+				require.Len(t, is, len(tc.out))
+				for idx := range is {
+					require.Zero(t, is[idx].BigInt().Cmp(tc.out[idx].BigInt()))
+				}
+			})
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name  string
+			in    string
+			index int
+		}{
+			{
+				"invalid hex",
+				`["0x0", "0xg"]`,
+				1,
+			},
+			{
+				"negative",
+				`["0x0", "0x1", -1]`,
+				2,
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := bigutil.DecodeJSONArray(json.NewDecoder(strings.NewReader(tc.in)))
+				require.Error(t, err)
+
+				var idxErr *bigutil.IndexError
+				require.True(t, errors.As(err, &idxErr))
+				require.Equal(t, tc.index, idxErr.Index)
+			})
+		}
+
+		t.Run("not an array", func(t *testing.T) {
+			_, err := bigutil.DecodeJSONArray(json.NewDecoder(strings.NewReader(`{}`)))
+			require.Error(t, err)
+		})
+
+		t.Run("strict parsing", func(t *testing.T) {
+			bigutil.StrictParsing = true
+			t.Cleanup(func() {
+				bigutil.StrictParsing = false
+			})
+
+			for _, elem := range []string{`"0x0001"`, `"0XFF"`, `"0255"`} {
+				var i bigutil.Uint256
+				require.Error(t, json.Unmarshal([]byte(elem), &i))
+
+				_, err := bigutil.DecodeJSONArray(json.NewDecoder(strings.NewReader(`["0x1", ` + elem + `]`)))
+
+				var idxErr *bigutil.IndexError
+				require.True(t, errors.As(err, &idxErr))
+				require.Equal(t, 1, idxErr.Index)
+			}
+		})
+	})
+}
+
+func TestDecodeUint256Array(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		in := `["0x1", "2", 3] {"next": "value"}`
+		dec := json.NewDecoder(strings.NewReader(in))
+
+		var sum uint64
+		require.Nil(t, bigutil.DecodeUint256Array(dec, func(i bigutil.Uint256) error {
+			sum += i.BigInt().Uint64()
+			return nil
+		}))
+		require.Equal(t, uint64(6), sum)
+
+		// The decoder is positioned right after the array.
+		var next map[string]string
+		require.Nil(t, dec.Decode(&next))
+		require.Equal(t, "value", next["next"])
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		errStop := errors.New("stop")
+
+		count := 0
+		err := bigutil.DecodeUint256Array(json.NewDecoder(strings.NewReader(`["0x1", "0x2", "0x3"]`)), func(i bigutil.Uint256) error {
+			count++
+			if i.EqualUint64(2) {
+				return errStop
+			}
+
+			return nil
+		})
+		require.ErrorIs(t, err, errStop)
+		require.Equal(t, 2, count)
+
+		var idxErr *bigutil.IndexError
+		require.True(t, errors.As(err, &idxErr))
+		require.Equal(t, 1, idxErr.Index)
+	})
+}
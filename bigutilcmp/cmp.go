@@ -0,0 +1,23 @@
+// Package bigutilcmp provides go-cmp options for the types in bigutil.
+package bigutilcmp
+
+import (
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+)
+
+// Comparer returns a cmp.Option that compares Uint256 values numerically.
+func Comparer() cmp.Option {
+	return cmp.Comparer(func(x, y bigutil.Uint256) bool {
+		return x.BigIntUnsafe().Cmp(y.BigIntUnsafe()) == 0
+	})
+}
+
+// Transformer returns a cmp.Option that transforms Uint256 values into hex strings,
+// so that they are compared numerically and reported readably in diffs.
+func Transformer() cmp.Option {
+	return cmp.Transformer("Uint256", func(i bigutil.Uint256) string {
+		return i.String()
+	})
+}
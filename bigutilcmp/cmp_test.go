@@ -0,0 +1,36 @@
+package bigutilcmp_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+	"github.com/m0t0k1ch1-go/bigutil/v2/bigutilcmp"
+)
+
+type record struct {
+	Amount bigutil.Uint256
+}
+
+func TestComparer(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		x := record{bigutil.Uint64ToUint256(1)}
+
+		require.True(t, cmp.Equal(x, record{bigutil.MustHexToUint256("0x1")}, bigutilcmp.Comparer()))
+		require.False(t, cmp.Equal(x, record{bigutil.Uint64ToUint256(2)}, bigutilcmp.Comparer()))
+	})
+}
+
+func TestTransformer(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		x := record{bigutil.Uint64ToUint256(1)}
+
+		require.Empty(t, cmp.Diff(x, record{bigutil.MustHexToUint256("0x1")}, bigutilcmp.Transformer()))
+
+		diff := cmp.Diff(x, record{bigutil.Uint64ToUint256(255)}, bigutilcmp.Transformer())
+		require.Contains(t, diff, `"0x1"`)
+		require.Contains(t, diff, `"0xff"`)
+	})
+}
@@ -0,0 +1,29 @@
+module github.com/m0t0k1ch1-go/bigutil/v2/bigutilk8s
+
+go 1.23
+
+require (
+	github.com/m0t0k1ch1-go/bigutil/v2 v2.0.0
+	github.com/stretchr/testify v1.10.0
+	gopkg.in/inf.v0 v0.9.1
+	k8s.io/apimachinery v0.31.3
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.2-0.20180830191138-d8f796af33cc // indirect
+	github.com/fxamacker/cbor/v2 v2.7.0 // indirect
+	github.com/gogo/protobuf v1.3.2 // indirect
+	github.com/oklog/ulid/v2 v2.1.0 // indirect
+	github.com/pmezard/go-difflib v1.0.1-0.20181226105442-5d4384ee4fb2 // indirect
+	github.com/samber/lo v1.47.0 // indirect
+	github.com/samber/oops v1.14.1 // indirect
+	github.com/x448/float16 v0.8.4 // indirect
+	go.opentelemetry.io/otel v1.32.0 // indirect
+	go.opentelemetry.io/otel/trace v1.32.0 // indirect
+	golang.org/x/crypto v0.22.0 // indirect
+	golang.org/x/sys v0.29.0 // indirect
+	golang.org/x/text v0.20.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+replace github.com/m0t0k1ch1-go/bigutil/v2 => ../
@@ -0,0 +1,56 @@
+// Package bigutilk8s provides conversions between the types in bigutil and Kubernetes resource quantities.
+package bigutilk8s
+
+import (
+	"fmt"
+	"math/big"
+
+	"gopkg.in/inf.v0"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+)
+
+// maxDecimalDigits is the number of decimal digits of 2^256 - 1.
+const maxDecimalDigits = 78
+
+// ToQuantity converts x to a resource.Quantity in the DecimalSI format.
+func ToQuantity(x bigutil.Uint256) resource.Quantity {
+	return *resource.NewDecimalQuantity(*inf.NewDecBig(x.BigInt(), 0), resource.DecimalSI)
+}
+
+// FromQuantity converts the given resource.Quantity to Uint256.
+// It returns an error if q is negative, has a fractional part (e.g. "1500m") or does not fit in 256 bits.
+func FromQuantity(q resource.Quantity) (bigutil.Uint256, error) {
+	dec := q.AsDec()
+
+	x := new(big.Int).Set(dec.UnscaledBig())
+	if x.Sign() == 0 {
+		return bigutil.Uint256{}, nil
+	}
+
+	// Quantities may come from untrusted input, so huge exponents are rejected before 10^exp is computed.
+	if scale := int64(dec.Scale()); scale < 0 {
+		if -scale > maxDecimalDigits {
+			return bigutil.Uint256{}, fmt.Errorf("quantity must be less than or equal to 256 bits: %s", q.String())
+		}
+		x.Mul(x, new(big.Int).Exp(big.NewInt(10), big.NewInt(-scale), nil))
+	} else if scale > 0 {
+		// 10^scale is greater than x, so the quotient cannot be a non-zero integer.
+		if scale > int64(x.BitLen()) {
+			return bigutil.Uint256{}, fmt.Errorf("quantity must be an integer: %s", q.String())
+		}
+		var rem big.Int
+		x.QuoRem(x, new(big.Int).Exp(big.NewInt(10), big.NewInt(scale), nil), &rem)
+		if rem.Sign() != 0 {
+			return bigutil.Uint256{}, fmt.Errorf("quantity must be an integer: %s", q.String())
+		}
+	}
+
+	i, err := bigutil.BigIntToUint256(x)
+	if err != nil {
+		return bigutil.Uint256{}, fmt.Errorf("quantity %s: %w", q.String(), err)
+	}
+
+	return i, nil
+}
@@ -0,0 +1,131 @@
+package bigutilk8s_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/inf.v0"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+	"github.com/m0t0k1ch1-go/bigutil/v2/bigutilk8s"
+)
+
+func TestToQuantity(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   bigutil.Uint256
+			out  string
+		}{
+			{
+				"zero",
+				bigutil.Zero(),
+				"0",
+			},
+			{
+				"small",
+				bigutil.Uint64ToUint256(1500),
+				"1500",
+			},
+			{
+				"max",
+				bigutil.MaxUint256(),
+				"115792089237316195423570985008687907853269984665640564039457584007913129639935",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				q := bigutilk8s.ToQuantity(tc.in)
+				require.Equal(t, tc.out, q.String())
+
+				out, err := bigutilk8s.FromQuantity(q)
+				require.Nil(t, err)
+				require.Equal(t, tc.in.String(), out.String())
+			})
+		}
+	})
+}
+
+func TestFromQuantity(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   string
+			out  bigutil.Uint256
+		}{
+			{
+				"suffix",
+				"2k",
+				bigutil.Uint64ToUint256(2000),
+			},
+			{
+				"binary suffix",
+				"1Ki",
+				bigutil.Uint64ToUint256(1024),
+			},
+			{
+				"milli integer",
+				"2000m",
+				bigutil.Uint64ToUint256(2),
+			},
+			{
+				"exponent",
+				"1e3",
+				bigutil.Uint64ToUint256(1000),
+			},
+			{
+				"zero with huge exponent",
+				"0e30000000",
+				bigutil.Zero(),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				out, err := bigutilk8s.FromQuantity(resource.MustParse(tc.in))
+				require.Nil(t, err)
+				require.Equal(t, tc.out.String(), out.String())
+			})
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   string
+		}{
+			{
+				"negative",
+				"-1",
+			},
+			{
+				"fractional",
+				"1500m",
+			},
+			{
+				"overflow",
+				"115792089237316195423570985008687907853269984665640564039457584007913129639936",
+			},
+			{
+				"huge exponent",
+				"1e30000000",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := bigutilk8s.FromQuantity(resource.MustParse(tc.in))
+				require.Error(t, err)
+			})
+		}
+
+		t.Run("huge scale", func(t *testing.T) {
+			for _, scale := range []inf.Scale{-30000000, 30000000} {
+				_, err := bigutilk8s.FromQuantity(*resource.NewDecimalQuantity(*inf.NewDec(1, scale), resource.DecimalSI))
+				require.Error(t, err)
+			}
+		})
+	})
+}
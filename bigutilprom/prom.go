@@ -0,0 +1,126 @@
+// Package bigutilprom provides Prometheus metrics backed by the types in bigutil.
+//
+// Prometheus samples are float64, so values above 2^53 lose precision.
+// Each metric therefore also reports the exact decimal value as the "value" label of a companion info metric
+// named with the "_info" suffix.
+package bigutilprom
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+)
+
+// Counter is a Uint256-backed cumulative metric.
+// It is safe for concurrent use.
+type Counter struct {
+	mu  sync.Mutex
+	acc bigutil.Accumulator
+
+	desc     *prometheus.Desc
+	infoDesc *prometheus.Desc
+}
+
+// NewCounter returns a new Counter described by the given options.
+func NewCounter(opts prometheus.CounterOpts) *Counter {
+	desc, infoDesc := newDescs(prometheus.Opts(opts))
+
+	return &Counter{
+		desc:     desc,
+		infoDesc: infoDesc,
+	}
+}
+
+// Add adds the given value to the counter.
+// It returns an error if the counter overflows, in which case the counter stops increasing.
+func (c *Counter) Add(x bigutil.Uint256) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.acc.Add(x)
+}
+
+// Value returns the current value of the counter.
+func (c *Counter) Value() bigutil.Uint256 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.acc.Total()
+}
+
+// Describe implements prometheus.Collector.
+func (c *Counter) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+	ch <- c.infoDesc
+}
+
+// Collect implements prometheus.Collector.
+func (c *Counter) Collect(ch chan<- prometheus.Metric) {
+	collect(ch, c.desc, c.infoDesc, prometheus.CounterValue, c.Value())
+}
+
+// Gauge is a Uint256-backed metric that can be set to arbitrary values.
+// It is safe for concurrent use.
+type Gauge struct {
+	mu    sync.Mutex
+	value bigutil.Uint256
+
+	desc     *prometheus.Desc
+	infoDesc *prometheus.Desc
+}
+
+// NewGauge returns a new Gauge described by the given options.
+func NewGauge(opts prometheus.GaugeOpts) *Gauge {
+	desc, infoDesc := newDescs(prometheus.Opts(opts))
+
+	return &Gauge{
+		desc:     desc,
+		infoDesc: infoDesc,
+	}
+}
+
+// Set sets the gauge to the given value.
+func (g *Gauge) Set(x bigutil.Uint256) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.value = x.Clone()
+}
+
+// Value returns the current value of the gauge.
+func (g *Gauge) Value() bigutil.Uint256 {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.value.Clone()
+}
+
+// Describe implements prometheus.Collector.
+func (g *Gauge) Describe(ch chan<- *prometheus.Desc) {
+	ch <- g.desc
+	ch <- g.infoDesc
+}
+
+// Collect implements prometheus.Collector.
+func (g *Gauge) Collect(ch chan<- prometheus.Metric) {
+	collect(ch, g.desc, g.infoDesc, prometheus.GaugeValue, g.Value())
+}
+
+func newDescs(opts prometheus.Opts) (*prometheus.Desc, *prometheus.Desc) {
+	name := prometheus.BuildFQName(opts.Namespace, opts.Subsystem, opts.Name)
+
+	desc := prometheus.NewDesc(name, opts.Help, nil, opts.ConstLabels)
+	infoDesc := prometheus.NewDesc(name+"_info", opts.Help+" (exact value)", []string{"value"}, opts.ConstLabels)
+
+	return desc, infoDesc
+}
+
+func collect(ch chan<- prometheus.Metric, desc, infoDesc *prometheus.Desc, valueType prometheus.ValueType, x bigutil.Uint256) {
+	f, _ := new(big.Float).SetInt(x.BigIntUnsafe()).Float64()
+
+	ch <- prometheus.MustNewConstMetric(desc, valueType, f)
+	ch <- prometheus.MustNewConstMetric(infoDesc, prometheus.GaugeValue, 1, x.BigIntUnsafe().String())
+}
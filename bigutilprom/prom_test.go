@@ -0,0 +1,68 @@
+package bigutilprom_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+	"github.com/m0t0k1ch1-go/bigutil/v2/bigutilprom"
+)
+
+func TestCounter(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := bigutilprom.NewCounter(prometheus.CounterOpts{
+			Namespace: "test",
+			Name:      "volume_total",
+			Help:      "Total volume.",
+		})
+
+		require.Nil(t, c.Add(bigutil.Uint64ToUint256(1<<53)))
+		require.Nil(t, c.Add(bigutil.Uint64ToUint256(1)))
+		require.Equal(t, bigutil.Uint64ToUint256(1<<53+1).String(), c.Value().String())
+
+		require.Nil(t, testutil.CollectAndCompare(c, strings.NewReader(`
+# HELP test_volume_total Total volume.
+# TYPE test_volume_total counter
+test_volume_total 9.007199254740992e+15
+# HELP test_volume_total_info Total volume. (exact value)
+# TYPE test_volume_total_info gauge
+test_volume_total_info{value="9007199254740993"} 1
+`)))
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		c := bigutilprom.NewCounter(prometheus.CounterOpts{
+			Name: "volume_total",
+		})
+
+		require.Nil(t, c.Add(bigutil.MaxUint256()))
+		require.Error(t, c.Add(bigutil.Uint64ToUint256(1)))
+		require.Equal(t, bigutil.MaxUint256().String(), c.Value().String())
+	})
+}
+
+func TestGauge(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		g := bigutilprom.NewGauge(prometheus.GaugeOpts{
+			Name: "balance",
+			Help: "Balance.",
+		})
+
+		g.Set(bigutil.Uint64ToUint256(10))
+		g.Set(bigutil.Uint64ToUint256(3))
+		require.Equal(t, "0x3", g.Value().String())
+
+		require.Nil(t, testutil.CollectAndCompare(g, strings.NewReader(`
+# HELP balance Balance.
+# TYPE balance gauge
+balance 3
+# HELP balance_info Balance. (exact value)
+# TYPE balance_info gauge
+balance_info{value="3"} 1
+`)))
+	})
+}
@@ -0,0 +1,78 @@
+// Package bigutilrapid provides rapid generators for the types in bigutil.
+package bigutilrapid
+
+import (
+	"math/big"
+
+	"pgregory.net/rapid"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+)
+
+// DefaultEdgePercent is the probability, in percent, that Uint256 and Uint256Range draw an edge value.
+const DefaultEdgePercent = 25
+
+// Uint256 returns a generator of Uint256 values over the full uint256 range.
+func Uint256() *rapid.Generator[bigutil.Uint256] {
+	return Uint256Range(bigutil.Zero(), bigutil.MaxUint256())
+}
+
+// Uint256Range returns a generator of Uint256 values in [lower, upper].
+func Uint256Range(lower, upper bigutil.Uint256) *rapid.Generator[bigutil.Uint256] {
+	return Uint256RangeWithEdgePercent(lower, upper, DefaultEdgePercent)
+}
+
+// Uint256RangeWithEdgePercent returns a generator of Uint256 values in [lower, upper]
+// that draws an edge value (the bounds, their neighbors and the powers of two around common integer widths)
+// with probability edgePercent percent, and a value spread over the whole range otherwise.
+// It panics if lower is greater than upper or edgePercent is not in [0, 100].
+func Uint256RangeWithEdgePercent(lower, upper bigutil.Uint256, edgePercent int) *rapid.Generator[bigutil.Uint256] {
+	lo, hi := lower.BigInt(), upper.BigInt()
+	if lo.Cmp(hi) > 0 {
+		panic("lower must be less than or equal to upper")
+	}
+	if edgePercent < 0 || edgePercent > 100 {
+		panic("edgePercent must be in [0, 100]")
+	}
+
+	edges := edgeValues(lo, hi)
+	width := new(big.Int).Sub(hi, lo)
+	width.Add(width, big.NewInt(1))
+
+	return rapid.Custom(func(t *rapid.T) bigutil.Uint256 {
+		if edgePercent > 0 && rapid.IntRange(0, 99).Draw(t, "edge") < edgePercent {
+			return bigutil.MustBigIntToUint256(edges[rapid.IntRange(0, len(edges)-1).Draw(t, "edgeIndex")])
+		}
+
+		b := rapid.SliceOfN(rapid.Byte(), 32, 32).Draw(t, "bytes")
+
+		x := new(big.Int).SetBytes(b)
+		x.Mod(x, width)
+		x.Add(x, lo)
+
+		return bigutil.MustBigIntToUint256(x)
+	})
+}
+
+func edgeValues(lo, hi *big.Int) []*big.Int {
+	candidates := []*big.Int{
+		lo,
+		new(big.Int).Add(lo, big.NewInt(1)),
+		new(big.Int).Sub(hi, big.NewInt(1)),
+		hi,
+	}
+	for _, n := range []uint{8, 16, 32, 64, 128, 255} {
+		p := new(big.Int).Lsh(big.NewInt(1), n)
+		candidates = append(candidates, new(big.Int).Sub(p, big.NewInt(1)), p)
+	}
+
+	var edges []*big.Int
+	for _, x := range candidates {
+		if x.Cmp(lo) < 0 || x.Cmp(hi) > 0 {
+			continue
+		}
+		edges = append(edges, x)
+	}
+
+	return edges
+}
@@ -0,0 +1,58 @@
+package bigutilrapid_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+	"github.com/m0t0k1ch1-go/bigutil/v2/bigutilrapid"
+)
+
+func TestUint256(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		i := bigutilrapid.Uint256().Draw(t, "i")
+
+		if i.BigInt().Sign() < 0 || i.BigInt().BitLen() > 256 {
+			t.Fatalf("out of range: %s", i)
+		}
+	})
+}
+
+func TestUint256Range(t *testing.T) {
+	lower, upper := bigutil.Uint64ToUint256(100), bigutil.Uint64ToUint256(200)
+
+	rapid.Check(t, func(t *rapid.T) {
+		i := bigutilrapid.Uint256Range(lower, upper).Draw(t, "i")
+
+		if i.BigInt().Cmp(lower.BigInt()) < 0 || i.BigInt().Cmp(upper.BigInt()) > 0 {
+			t.Fatalf("out of range: %s", i)
+		}
+	})
+}
+
+func TestUint256RangeWithEdgePercent(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		lower, upper := bigutil.Uint64ToUint256(0), bigutil.Uint64ToUint256(1000)
+
+		rapid.Check(t, func(t *rapid.T) {
+			i := bigutilrapid.Uint256RangeWithEdgePercent(lower, upper, 100).Draw(t, "i")
+
+			switch i.BigInt().Uint64() {
+			case 0, 1, 255, 256, 999, 1000:
+			default:
+				t.Fatalf("not an edge value: %s", i)
+			}
+		})
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		require.Panics(t, func() {
+			bigutilrapid.Uint256RangeWithEdgePercent(bigutil.Uint64ToUint256(1), bigutil.Uint64ToUint256(0), 0)
+		})
+		require.Panics(t, func() {
+			bigutilrapid.Uint256RangeWithEdgePercent(bigutil.Uint64ToUint256(0), bigutil.Uint64ToUint256(1), 101)
+		})
+	})
+}
@@ -0,0 +1,88 @@
+// Package bigutilredis provides go-redis helpers for the types in bigutil.
+//
+// bigutil.Uint256 implements encoding.BinaryMarshaler and encoding.BinaryUnmarshaler,
+// so it can be passed to go-redis commands as is and read back with Cmd.Scan,
+// stored as its compact binary representation.
+//
+// Sorted-set scores are float64 and lose precision above 2^53.
+// Z therefore prefixes each member with the exact 32-byte value:
+// Redis orders members of equal score lexicographically, which for the prefix is numerically,
+// so ZRANGE returns members in exact order and ParseMember recovers the exact value.
+// As the member changes with the value, the previous member must be removed (e.g. with ZREM) when a value is updated.
+package bigutilredis
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+)
+
+const valueLength = 32
+
+// Encode returns the compact binary representation of x.
+func Encode(x bigutil.Uint256) []byte {
+	b, _ := x.MarshalBinary()
+
+	return b
+}
+
+// Decode returns the Uint256 represented by the given compact binary representation.
+func Decode(b []byte) (bigutil.Uint256, error) {
+	var x bigutil.Uint256
+	if err := x.UnmarshalBinary(b); err != nil {
+		return bigutil.Uint256{}, err
+	}
+
+	return x, nil
+}
+
+// Score returns the sorted-set score of x, the nearest float64.
+// It is monotonic: x <= y implies Score(x) <= Score(y).
+func Score(x bigutil.Uint256) float64 {
+	f, _ := new(big.Float).SetInt(x.BigIntUnsafe()).Float64()
+
+	return f
+}
+
+// Member returns the sorted-set member that encodes x exactly, followed by the given id.
+func Member(x bigutil.Uint256, id string) string {
+	var b [valueLength]byte
+	x.BigIntUnsafe().FillBytes(b[:])
+
+	return string(b[:]) + id
+}
+
+// ParseMember returns the value and id encoded in the given member by Member.
+func ParseMember(member string) (bigutil.Uint256, string, error) {
+	if len(member) < valueLength {
+		return bigutil.Uint256{}, "", fmt.Errorf("member must be at least %d bytes", valueLength)
+	}
+
+	x, err := Decode([]byte(member[:valueLength]))
+	if err != nil {
+		return bigutil.Uint256{}, "", err
+	}
+
+	return x, member[valueLength:], nil
+}
+
+// Z returns the sorted-set entry for the given value and id, with Score(x) as the score and Member(x, id) as the member.
+func Z(x bigutil.Uint256, id string) redis.Z {
+	return redis.Z{
+		Score:  Score(x),
+		Member: Member(x, id),
+	}
+}
+
+// ParseZ returns the value and id encoded in the given sorted-set entry, as returned by ZRangeWithScores.
+func ParseZ(z redis.Z) (bigutil.Uint256, string, error) {
+	member, ok := z.Member.(string)
+	if !ok {
+		return bigutil.Uint256{}, "", fmt.Errorf("unexpected member type: %T", z.Member)
+	}
+
+	return ParseMember(member)
+}
@@ -0,0 +1,77 @@
+package bigutilredis_test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+	"github.com/m0t0k1ch1-go/bigutil/v2/bigutilredis"
+)
+
+func TestEncode(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		for _, x := range []bigutil.Uint256{bigutil.Zero(), bigutil.Uint64ToUint256(255), bigutil.MaxUint256()} {
+			out, err := bigutilredis.Decode(bigutilredis.Encode(x))
+			require.Nil(t, err)
+			require.Equal(t, x.String(), out.String())
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		_, err := bigutilredis.Decode(make([]byte, 33))
+		require.Error(t, err)
+	})
+}
+
+func TestScan(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		cmd := redis.NewStringResult(string(bigutilredis.Encode(bigutil.Uint64ToUint256(255))), nil)
+
+		var x bigutil.Uint256
+		require.Nil(t, cmd.Scan(&x))
+		require.True(t, x.EqualUint64(255))
+	})
+}
+
+func TestZ(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Both values round to the same float64 score.
+		x := bigutil.MustHexToUint256("0x20000000000000")
+		y := bigutil.MustHexToUint256("0x20000000000001")
+
+		zs := []redis.Z{
+			bigutilredis.Z(y, "alice"),
+			bigutilredis.Z(x, "bob"),
+		}
+		require.Equal(t, zs[0].Score, zs[1].Score)
+
+		// Sort as Redis does: by score, then by member.
+		sort.Slice(zs, func(a, b int) bool {
+			if zs[a].Score != zs[b].Score {
+				return zs[a].Score < zs[b].Score
+			}
+			return zs[a].Member.(string) < zs[b].Member.(string)
+		})
+
+		out, id, err := bigutilredis.ParseZ(zs[0])
+		require.Nil(t, err)
+		require.Equal(t, x.String(), out.String())
+		require.Equal(t, "bob", id)
+
+		out, id, err = bigutilredis.ParseZ(zs[1])
+		require.Nil(t, err)
+		require.Equal(t, y.String(), out.String())
+		require.Equal(t, "alice", id)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		_, _, err := bigutilredis.ParseMember("short")
+		require.Error(t, err)
+
+		_, _, err = bigutilredis.ParseZ(redis.Z{Member: 1})
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,62 @@
+// Package bigutiltest provides helpers for testing code that uses bigutil.
+package bigutiltest
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+)
+
+// MustParse parses the given hex or decimal string as Uint256.
+// It panics for invalid input.
+func MustParse(s string) bigutil.Uint256 {
+	var i bigutil.Uint256
+	if err := i.UnmarshalText([]byte(s)); err != nil {
+		panic(err)
+	}
+
+	return i
+}
+
+// RequireEqual fails the test immediately if got is not equal to want.
+func RequireEqual(tb testing.TB, want, got bigutil.Uint256) {
+	tb.Helper()
+
+	if want.BigIntUnsafe().Cmp(got.BigIntUnsafe()) != 0 {
+		tb.Fatalf("Uint256 values are not equal:\n\twant: %s\n\tgot:  %s", format(want), format(got))
+	}
+}
+
+// RequireNotEqual fails the test immediately if got is equal to want.
+func RequireNotEqual(tb testing.TB, want, got bigutil.Uint256) {
+	tb.Helper()
+
+	if want.BigIntUnsafe().Cmp(got.BigIntUnsafe()) == 0 {
+		tb.Fatalf("Uint256 values should not be equal:\n\tboth: %s", format(got))
+	}
+}
+
+// RequireInDelta fails the test immediately if the difference between want and got is greater than delta.
+func RequireInDelta(tb testing.TB, want, got, delta bigutil.Uint256) {
+	tb.Helper()
+
+	diff := new(big.Int).Sub(want.BigIntUnsafe(), got.BigIntUnsafe())
+	diff.Abs(diff)
+
+	if diff.Cmp(delta.BigIntUnsafe()) > 0 {
+		tb.Fatalf(
+			"Uint256 values are not within delta:\n\twant:  %s\n\tgot:   %s\n\tdelta: %s\n\tdiff:  %s",
+			format(want), format(got), format(delta), formatBigInt(diff),
+		)
+	}
+}
+
+func format(i bigutil.Uint256) string {
+	return formatBigInt(i.BigIntUnsafe())
+}
+
+func formatBigInt(x *big.Int) string {
+	return fmt.Sprintf("%#x (%s)", x, x.String())
+}
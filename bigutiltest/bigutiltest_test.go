@@ -0,0 +1,89 @@
+package bigutiltest_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+	"github.com/m0t0k1ch1-go/bigutil/v2/bigutiltest"
+)
+
+type recorder struct {
+	testing.TB
+	failed bool
+	msg    string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Fatalf(format string, args ...any) {
+	r.failed = true
+	r.msg = fmt.Sprintf(format, args...)
+}
+
+func TestMustParse(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		require.Zero(t, bigutiltest.MustParse("0xff").BigInt().Cmp(bigutil.Uint64ToUint256(255).BigInt()))
+		require.Zero(t, bigutiltest.MustParse("255").BigInt().Cmp(bigutil.Uint64ToUint256(255).BigInt()))
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		require.Panics(t, func() {
+			bigutiltest.MustParse("-1")
+		})
+	})
+}
+
+func TestRequireEqual(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		r := &recorder{TB: t}
+		bigutiltest.RequireEqual(r, bigutil.Uint64ToUint256(1), bigutil.MustHexToUint256("0x1"))
+
+		require.False(t, r.failed)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		r := &recorder{TB: t}
+		bigutiltest.RequireEqual(r, bigutil.Uint64ToUint256(1), bigutil.Uint64ToUint256(255))
+
+		require.True(t, r.failed)
+		require.Contains(t, r.msg, "0x1 (1)")
+		require.Contains(t, r.msg, "0xff (255)")
+	})
+}
+
+func TestRequireNotEqual(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		r := &recorder{TB: t}
+		bigutiltest.RequireNotEqual(r, bigutil.Uint64ToUint256(1), bigutil.Uint64ToUint256(2))
+
+		require.False(t, r.failed)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		r := &recorder{TB: t}
+		bigutiltest.RequireNotEqual(r, bigutil.Uint64ToUint256(1), bigutil.Uint64ToUint256(1))
+
+		require.True(t, r.failed)
+	})
+}
+
+func TestRequireInDelta(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		r := &recorder{TB: t}
+		bigutiltest.RequireInDelta(r, bigutil.Uint64ToUint256(10), bigutil.Uint64ToUint256(12), bigutil.Uint64ToUint256(2))
+		bigutiltest.RequireInDelta(r, bigutil.Uint64ToUint256(12), bigutil.Uint64ToUint256(10), bigutil.Uint64ToUint256(2))
+
+		require.False(t, r.failed)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		r := &recorder{TB: t}
+		bigutiltest.RequireInDelta(r, bigutil.Uint64ToUint256(10), bigutil.Uint64ToUint256(13), bigutil.Uint64ToUint256(2))
+
+		require.True(t, r.failed)
+		require.Contains(t, r.msg, "0x3 (3)")
+	})
+}
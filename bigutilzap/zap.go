@@ -0,0 +1,47 @@
+// Package bigutilzap provides zap field constructors for the types in bigutil.
+package bigutilzap
+
+import (
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+)
+
+// Uint256 returns a field that logs the given value as a hex string.
+func Uint256(key string, x bigutil.Uint256) zap.Field {
+	return Uint256As(key, x, bigutil.FormatHex)
+}
+
+// Uint256As returns a field that logs the given value as a string in the given format.
+// An invalid format falls back to bigutil.FormatHex, so that logging never panics.
+func Uint256As(key string, x bigutil.Uint256, f bigutil.Format) zap.Field {
+	return zap.String(key, x.Text(validFormat(f)))
+}
+
+// Object returns a field that logs the given value as an object
+// holding its representation in each of the given formats, keyed by the format name.
+// If no format is given, bigutil.FormatHex is used; an invalid format falls back to it as well.
+func Object(key string, x bigutil.Uint256, fs ...bigutil.Format) zap.Field {
+	if len(fs) == 0 {
+		fs = []bigutil.Format{bigutil.FormatHex}
+	}
+
+	return zap.Object(key, zapcore.ObjectMarshalerFunc(func(enc zapcore.ObjectEncoder) error {
+		for _, f := range fs {
+			f = validFormat(f)
+			enc.AddString(f.String(), x.Text(f))
+		}
+
+		return nil
+	}))
+}
+
+// validFormat returns f if it is valid, and bigutil.FormatHex otherwise.
+func validFormat(f bigutil.Format) bigutil.Format {
+	if !f.IsValid() {
+		return bigutil.FormatHex
+	}
+
+	return f
+}
@@ -0,0 +1,54 @@
+package bigutilzap_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+	"github.com/m0t0k1ch1-go/bigutil/v2/bigutilzap"
+)
+
+func TestUint256(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		core, logs := observer.New(zapcore.InfoLevel)
+		zap.New(core).Info("test",
+			bigutilzap.Uint256("hex", bigutil.Uint64ToUint256(255)),
+			bigutilzap.Uint256As("decimal", bigutil.Uint64ToUint256(255), bigutil.FormatDecimal),
+			bigutilzap.Uint256As("invalid", bigutil.Uint64ToUint256(255), bigutil.Format(-1)),
+		)
+
+		require.Equal(t, map[string]any{
+			"hex":     "0xff",
+			"decimal": "255",
+			"invalid": "0xff",
+		}, logs.All()[0].ContextMap())
+	})
+}
+
+func TestObject(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		core, logs := observer.New(zapcore.InfoLevel)
+		zap.New(core).Info("test",
+			bigutilzap.Object("default", bigutil.Uint64ToUint256(255)),
+			bigutilzap.Object("both", bigutil.Uint64ToUint256(255), bigutil.FormatHex, bigutil.FormatDecimal),
+			bigutilzap.Object("invalid", bigutil.Uint64ToUint256(255), bigutil.Format(-1)),
+		)
+
+		require.Equal(t, map[string]any{
+			"default": map[string]any{
+				"hex": "0xff",
+			},
+			"both": map[string]any{
+				"hex":     "0xff",
+				"decimal": "255",
+			},
+			"invalid": map[string]any{
+				"hex": "0xff",
+			},
+		}, logs.All()[0].ContextMap())
+	})
+}
@@ -0,0 +1,40 @@
+// Package bigutilzerolog provides zerolog marshalers for the types in bigutil.
+package bigutilzerolog
+
+import (
+	"github.com/rs/zerolog"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+)
+
+// Uint256 is a zerolog.LogObjectMarshaler that logs Value as an object
+// holding its representation in each of Formats, keyed by the format name.
+// If Formats is empty, bigutil.FormatHex is used; an invalid format falls back to it as well, so that logging never panics.
+type Uint256 struct {
+	Value   bigutil.Uint256
+	Formats []bigutil.Format
+}
+
+// Object returns a Uint256 for the given value and formats,
+// to be passed to zerolog.Event.Object.
+func Object(x bigutil.Uint256, fs ...bigutil.Format) Uint256 {
+	return Uint256{
+		Value:   x,
+		Formats: fs,
+	}
+}
+
+// MarshalZerologObject implements zerolog.LogObjectMarshaler.
+func (u Uint256) MarshalZerologObject(e *zerolog.Event) {
+	fs := u.Formats
+	if len(fs) == 0 {
+		fs = []bigutil.Format{bigutil.FormatHex}
+	}
+
+	for _, f := range fs {
+		if !f.IsValid() {
+			f = bigutil.FormatHex
+		}
+		e.Str(f.String(), u.Value.Text(f))
+	}
+}
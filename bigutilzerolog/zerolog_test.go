@@ -0,0 +1,31 @@
+package bigutilzerolog_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+	"github.com/m0t0k1ch1-go/bigutil/v2/bigutilzerolog"
+)
+
+func TestUint256(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		var buf bytes.Buffer
+		logger := zerolog.New(&buf)
+		logger.Info().
+			Object("default", bigutilzerolog.Object(bigutil.Uint64ToUint256(255))).
+			Object("both", bigutilzerolog.Object(bigutil.Uint64ToUint256(255), bigutil.FormatHex, bigutil.FormatDecimal)).
+			Object("invalid", bigutilzerolog.Object(bigutil.Uint64ToUint256(255), bigutil.Format(-1))).
+			Send()
+
+		require.JSONEq(t, `{
+			"level": "info",
+			"default": {"hex": "0xff"},
+			"both": {"hex": "0xff", "decimal": "255"},
+			"invalid": {"hex": "0xff"}
+		}`, buf.String())
+	})
+}
@@ -0,0 +1,66 @@
+package bigutil
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+// It returns the minimal big-endian bytes, which are empty for zero.
+func (i Uint256) MarshalBinary() ([]byte, error) {
+	return i.x.Bytes(), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+// It accepts big-endian bytes of up to 32 bytes, with or without leading zero bytes.
+func (i *Uint256) UnmarshalBinary(data []byte) error {
+	if len(data) > maxByteLength {
+		return errorf("data must be less than or equal to %d bytes", maxByteLength)
+	}
+
+	i.x.SetBytes(data)
+
+	return nil
+}
+
+// Bytes32 returns the big-endian 32-byte representation of i.
+func (i Uint256) Bytes32() [maxByteLength]byte {
+	return i.bytes32()
+}
+
+// Bytes32LE returns the little-endian 32-byte representation of i,
+// as expected by e.g. SSZ and Solana programs.
+func (i Uint256) Bytes32LE() [maxByteLength]byte {
+	b := i.bytes32()
+	reverseBytes(b[:])
+
+	return b
+}
+
+// ReverseBytes returns i with the byte order of its 32-byte representation reversed,
+// converting a little-endian word to big-endian and vice versa.
+func (i Uint256) ReverseBytes() Uint256 {
+	b := i.Bytes32LE()
+
+	r := Uint256{}
+	r.x.SetBytes(b[:])
+
+	return r
+}
+
+// SetBytesLE sets i to the value of the given little-endian bytes, reusing the storage of i.
+// i is left unchanged if b is longer than 32 bytes.
+func (i *Uint256) SetBytesLE(b []byte) error {
+	if len(b) > maxByteLength {
+		return errorf("must be less than or equal to %d bytes", maxByteLength)
+	}
+
+	var be [maxByteLength]byte
+	copy(be[:], b)
+	reverseBytes(be[:])
+
+	i.x.SetBytes(be[:])
+
+	return nil
+}
+
+func reverseBytes(b []byte) {
+	for l, r := 0, len(b)-1; l < r; l, r = l+1, r-1 {
+		b[l], b[r] = b[r], b[l]
+	}
+}
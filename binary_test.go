@@ -0,0 +1,175 @@
+package bigutil_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+)
+
+func TestUint256MarshalBinary(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   bigutil.Uint256
+			out  []byte
+		}{
+			{
+				"zero",
+				bigutil.Zero(),
+				[]byte{},
+			},
+			{
+				"one",
+				bigutil.One(),
+				[]byte{0x01},
+			},
+			{
+				"max",
+				bigutil.MaxUint256(),
+				bytes.Repeat([]byte{0xff}, 32),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				b, err := tc.in.MarshalBinary()
+				require.Nil(t, err)
+				require.Equal(t, tc.out, b)
+
+				var i bigutil.Uint256
+				require.Nil(t, i.UnmarshalBinary(b))
+				require.Zero(t, i.BigInt().Cmp(tc.in.BigInt()))
+			})
+		}
+	})
+}
+
+func TestUint256UnmarshalBinary(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		var i bigutil.Uint256
+		require.Nil(t, i.UnmarshalBinary(append(make([]byte, 31), 0x01)))
+
+		require.True(t, i.EqualUint64(1))
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		var i bigutil.Uint256
+		require.Error(t, i.UnmarshalBinary(make([]byte, 33)))
+	})
+
+	t.Run("gob", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.Nil(t, gob.NewEncoder(&buf).Encode(bigutil.MaxUint256()))
+
+		var i bigutil.Uint256
+		require.Nil(t, gob.NewDecoder(&buf).Decode(&i))
+		require.Zero(t, i.BigInt().Cmp(bigutil.MaxUint256().BigInt()))
+	})
+}
+
+func TestUint256Bytes32(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		i := bigutil.MustHexToUint256("0x102")
+
+		be := i.Bytes32()
+		require.Equal(t, append(make([]byte, 30), 0x01, 0x02), be[:])
+
+		le := i.Bytes32LE()
+		require.Equal(t, append([]byte{0x02, 0x01}, make([]byte, 30)...), le[:])
+	})
+}
+
+func TestUint256ReverseBytes(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   bigutil.Uint256
+			out  bigutil.Uint256
+		}{
+			{
+				"zero",
+				bigutil.Zero(),
+				bigutil.Zero(),
+			},
+			{
+				"one",
+				bigutil.One(),
+				bigutil.MustHexToUint256("0x100000000000000000000000000000000000000000000000000000000000000"),
+			},
+			{
+				"bytes",
+				bigutil.Uint64ToUint256(0x0102),
+				bigutil.MustHexToUint256("0x201000000000000000000000000000000000000000000000000000000000000"),
+			},
+			{
+				"max",
+				bigutil.MaxUint256(),
+				bigutil.MaxUint256(),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				out := tc.in.ReverseBytes()
+				require.Zero(t, out.BigInt().Cmp(tc.out.BigInt()))
+
+				require.Zero(t, out.ReverseBytes().BigInt().Cmp(tc.in.BigInt()))
+			})
+		}
+	})
+}
+
+func TestUint256SetBytesLE(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			out  bigutil.Uint256
+		}{
+			{
+				"empty",
+				[]byte{},
+				bigutil.Zero(),
+			},
+			{
+				"short",
+				[]byte{0x02, 0x01},
+				bigutil.Uint64ToUint256(0x0102),
+			},
+			{
+				"max",
+				bytes.Repeat([]byte{0xff}, 32),
+				bigutil.MaxUint256(),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var i bigutil.Uint256
+				require.Nil(t, i.SetBytesLE(tc.in))
+
+				require.Zero(t, i.BigInt().Cmp(tc.out.BigInt()))
+			})
+		}
+
+		t.Run("round trip", func(t *testing.T) {
+			x := bigutil.MustHexToUint256("0x102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20")
+			le := x.Bytes32LE()
+
+			var i bigutil.Uint256
+			require.Nil(t, i.SetBytesLE(le[:]))
+			require.Equal(t, x.String(), i.String())
+		})
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		i := bigutil.One()
+		require.Error(t, i.SetBytesLE(make([]byte, 33)))
+
+		require.True(t, i.EqualUint64(1))
+	})
+}
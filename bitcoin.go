@@ -0,0 +1,87 @@
+package bigutil
+
+import (
+	"math/big"
+)
+
+// bitcoinMaxTarget is the target of difficulty 1 on the Bitcoin mainnet.
+var bitcoinMaxTarget = MustHexToUint256("0xffff0000000000000000000000000000000000000000000000000000")
+
+// BitcoinMaxTarget returns the target of difficulty 1 on the Bitcoin mainnet, which corresponds to nBits 0x1d00ffff.
+func BitcoinMaxTarget() Uint256 {
+	return bitcoinMaxTarget.Clone()
+}
+
+// CompactToTarget converts the given compact representation (nBits) to a Bitcoin target.
+// It returns an error if the compact representation is negative or overflows uint256.
+func CompactToTarget(bits uint32) (Uint256, error) {
+	exponent := bits >> 24
+	mantissa := bits & 0x007fffff
+
+	if mantissa != 0 && bits&0x00800000 != 0 {
+		return Uint256{}, errorf("must not be negative")
+	}
+
+	x := new(big.Int).SetUint64(uint64(mantissa))
+	if exponent <= 3 {
+		x.Rsh(x, uint(8*(3-exponent)))
+	} else {
+		x.Lsh(x, uint(8*(exponent-3)))
+	}
+
+	i := Uint256{}
+	if err := i.setBigInt(x); err != nil {
+		return Uint256{}, err
+	}
+
+	return i, nil
+}
+
+// TargetToCompact converts the given Bitcoin target to the compact representation (nBits).
+// The conversion is lossy: only the 3 most significant bytes of the target are kept.
+func TargetToCompact(target Uint256) uint32 {
+	size := uint32(len(target.x.Bytes()))
+
+	var mantissa uint32
+	if size <= 3 {
+		mantissa = uint32(target.x.Uint64() << (8 * (3 - size)))
+	} else {
+		mantissa = uint32(new(big.Int).Rsh(&target.x, uint(8*(size-3))).Uint64())
+	}
+
+	// The sign bit must not be set, so the mantissa is shifted into the next byte.
+	if mantissa&0x00800000 != 0 {
+		mantissa >>= 8
+		size++
+	}
+
+	return mantissa | size<<24
+}
+
+// TargetToDifficulty returns the Bitcoin difficulty of the given target, i.e. BitcoinMaxTarget() / target, exactly.
+// It returns an error if target is zero.
+func TargetToDifficulty(target Uint256) (*big.Rat, error) {
+	if target.x.Sign() == 0 {
+		return nil, errorf("target must not be zero")
+	}
+
+	return new(big.Rat).SetFrac(bitcoinMaxTarget.BigInt(), target.BigInt()), nil
+}
+
+// DifficultyToTarget returns the Bitcoin target of the given difficulty, i.e. floor(BitcoinMaxTarget() / difficulty).
+// It returns an error if difficulty is not positive or the target overflows uint256.
+func DifficultyToTarget(difficulty *big.Rat) (Uint256, error) {
+	if difficulty.Sign() <= 0 {
+		return Uint256{}, errorf("difficulty must be positive")
+	}
+
+	x := new(big.Int).Mul(&bitcoinMaxTarget.x, difficulty.Denom())
+	x.Quo(x, difficulty.Num())
+
+	i := Uint256{}
+	if err := i.setBigInt(x); err != nil {
+		return Uint256{}, err
+	}
+
+	return i, nil
+}
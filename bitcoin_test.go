@@ -0,0 +1,146 @@
+package bigutil_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+)
+
+func TestCompactToTarget(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   uint32
+			out  string
+		}{
+			{
+				"genesis",
+				0x1d00ffff,
+				"0xffff0000000000000000000000000000000000000000000000000000",
+			},
+			{
+				"block 100000",
+				0x1b04864c,
+				"0x4864c000000000000000000000000000000000000000000000000",
+			},
+			{
+				"small exponent",
+				0x01123456,
+				"0x12",
+			},
+			{
+				"truncated to zero",
+				0x01003456,
+				"0x0",
+			},
+			{
+				"zero mantissa with sign bit",
+				0x01800000,
+				"0x0",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				i, err := bigutil.CompactToTarget(tc.in)
+				require.Nil(t, err)
+
+				require.Equal(t, tc.out, i.String())
+			})
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		_, err := bigutil.CompactToTarget(0x04923456)
+		require.Error(t, err)
+
+		_, err = bigutil.CompactToTarget(0xff123456)
+		require.Error(t, err)
+	})
+}
+
+func TestTargetToCompact(t *testing.T) {
+	tcs := []struct {
+		name string
+		in   string
+		out  uint32
+	}{
+		{
+			"genesis",
+			"0xffff0000000000000000000000000000000000000000000000000000",
+			0x1d00ffff,
+		},
+		{
+			"small",
+			"0x12",
+			0x01120000,
+		},
+		{
+			"sign bit",
+			"0x80",
+			0x02008000,
+		},
+		{
+			"lossy",
+			"0x92345678",
+			0x05009234,
+		},
+		{
+			"zero",
+			"0x0",
+			0,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.out, bigutil.TargetToCompact(bigutil.MustHexToUint256(tc.in)))
+		})
+	}
+}
+
+func TestTargetToDifficulty(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		target, err := bigutil.CompactToTarget(0x1b0404cb)
+		require.Nil(t, err)
+
+		d, err := bigutil.TargetToDifficulty(target)
+		require.Nil(t, err)
+		require.Equal(t, "16307.420939", d.FloatString(6))
+
+		d, err = bigutil.TargetToDifficulty(bigutil.BitcoinMaxTarget())
+		require.Nil(t, err)
+		require.Equal(t, "1", d.RatString())
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		_, err := bigutil.TargetToDifficulty(bigutil.Uint64ToUint256(0))
+		require.Error(t, err)
+	})
+}
+
+func TestDifficultyToTarget(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		target, err := bigutil.DifficultyToTarget(big.NewRat(1, 1))
+		require.Nil(t, err)
+		require.Zero(t, target.BigInt().Cmp(bigutil.BitcoinMaxTarget().BigInt()))
+
+		target, err = bigutil.DifficultyToTarget(big.NewRat(4294901760, 263371))
+		require.Nil(t, err)
+		require.Equal(t, uint32(0x1b0404cb), bigutil.TargetToCompact(target))
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		_, err := bigutil.DifficultyToTarget(big.NewRat(0, 1))
+		require.Error(t, err)
+
+		_, err = bigutil.DifficultyToTarget(big.NewRat(-1, 1))
+		require.Error(t, err)
+
+		_, err = bigutil.DifficultyToTarget(big.NewRat(1, 1<<62))
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,187 @@
+package bigutil
+
+import "math/bits"
+
+// And returns i & j.
+func (i Uint256) And(j Uint256) Uint256 {
+	r := Uint256{}
+	r.x.And(&i.x, &j.x)
+
+	return r
+}
+
+// Or returns i | j.
+func (i Uint256) Or(j Uint256) Uint256 {
+	r := Uint256{}
+	r.x.Or(&i.x, &j.x)
+
+	return r
+}
+
+// Xor returns i ^ j.
+func (i Uint256) Xor(j Uint256) Uint256 {
+	r := Uint256{}
+	r.x.Xor(&i.x, &j.x)
+
+	return r
+}
+
+// Not returns ^i over the full 256-bit width, i.e. MaxUint256 - i.
+func (i Uint256) Not() Uint256 {
+	r := Uint256{}
+	r.x.Xor(&i.x, maxBig256)
+
+	return r
+}
+
+// Lsh returns (i << n) mod 2^256, as the EVM SHL opcode does.
+func (i Uint256) Lsh(n uint) Uint256 {
+	r := Uint256{}
+	if n >= maxBitLength {
+		return r
+	}
+
+	r.x.Lsh(&i.x, n)
+
+	r.truncate()
+
+	return r
+}
+
+// Rsh returns i >> n, as the EVM SHR opcode does.
+func (i Uint256) Rsh(n uint) Uint256 {
+	r := Uint256{}
+	if n >= maxBitLength {
+		return r
+	}
+
+	r.x.Rsh(&i.x, n)
+
+	return r
+}
+
+// CheckedLsh returns i << n.
+// It returns an error if any set bit is shifted out of the 256-bit width.
+func (i Uint256) CheckedLsh(n uint) (Uint256, error) {
+	if i.x.Sign() != 0 && (n >= maxBitLength || uint(i.x.BitLen())+n > maxBitLength) {
+		return Uint256{}, errorf("result must be less than or equal to %d bits", maxBitLength)
+	}
+
+	return i.Lsh(n), nil
+}
+
+// CheckedRsh returns i >> n.
+// It returns an error if any set bit is shifted out, i.e. if the shift is not exact.
+func (i Uint256) CheckedRsh(n uint) (Uint256, error) {
+	if i.x.Sign() != 0 && i.x.TrailingZeroBits() < n {
+		return Uint256{}, errorf("shifted out bits must be zero")
+	}
+
+	return i.Rsh(n), nil
+}
+
+// Log2 returns floor(log2(i)), i.e. the index of the most significant set bit.
+// It returns an error if i is zero.
+func (i Uint256) Log2() (int, error) {
+	if i.x.Sign() == 0 {
+		return 0, errorf("value must be positive")
+	}
+
+	return i.x.BitLen() - 1, nil
+}
+
+// LeadingZeros returns the number of leading zero bits in the 256-bit width of i; the result is 256 for zero.
+func (i Uint256) LeadingZeros() int {
+	return maxBitLength - i.x.BitLen()
+}
+
+// TrailingZeros returns the number of trailing zero bits in i; the result is 256 for zero.
+func (i Uint256) TrailingZeros() int {
+	if i.x.Sign() == 0 {
+		return maxBitLength
+	}
+
+	return int(i.x.TrailingZeroBits())
+}
+
+// OnesCount returns the number of set bits in i.
+func (i Uint256) OnesCount() int {
+	n := 0
+	for _, w := range i.x.Bits() {
+		n += bits.OnesCount(uint(w))
+	}
+
+	return n
+}
+
+// IsPowerOfTwo reports whether i is a power of two.
+func (i Uint256) IsPowerOfTwo() bool {
+	return i.x.Sign() != 0 && int(i.x.TrailingZeroBits()) == i.x.BitLen()-1
+}
+
+// NextPowerOfTwo returns the smallest power of two greater than or equal to i; the result is 1 for zero.
+// It returns an error if i is greater than 2^255.
+func (i Uint256) NextPowerOfTwo() (Uint256, error) {
+	if i.IsPowerOfTwo() {
+		return i.Clone(), nil
+	}
+
+	n := uint(i.x.BitLen())
+	if n >= maxBitLength {
+		return Uint256{}, errorf("result must be less than or equal to %d bits", maxBitLength)
+	}
+
+	return One().Lsh(n), nil
+}
+
+// PrevPowerOfTwo returns the largest power of two less than or equal to i.
+// It returns an error if i is zero.
+func (i Uint256) PrevPowerOfTwo() (Uint256, error) {
+	if i.x.Sign() == 0 {
+		return Uint256{}, errorf("value must be positive")
+	}
+
+	return One().Lsh(uint(i.x.BitLen() - 1)), nil
+}
+
+// SetBit sets the n-th bit of i, counted from the least significant bit, reusing the storage of i.
+// i is left unchanged if n is not less than 256.
+func (i *Uint256) SetBit(n uint) error {
+	if err := validateBitIndex(n); err != nil {
+		return err
+	}
+
+	i.x.SetBit(&i.x, int(n), 1)
+
+	return nil
+}
+
+// ClearBit clears the n-th bit of i, counted from the least significant bit, reusing the storage of i.
+// i is left unchanged if n is not less than 256.
+func (i *Uint256) ClearBit(n uint) error {
+	if err := validateBitIndex(n); err != nil {
+		return err
+	}
+
+	i.x.SetBit(&i.x, int(n), 0)
+
+	return nil
+}
+
+// TestBit reports whether the n-th bit of i, counted from the least significant bit, is set.
+// It returns an error if n is not less than 256.
+func (i Uint256) TestBit(n uint) (bool, error) {
+	if err := validateBitIndex(n); err != nil {
+		return false, err
+	}
+
+	return i.x.Bit(int(n)) == 1, nil
+}
+
+func validateBitIndex(n uint) error {
+	if n >= maxBitLength {
+		return errorf("index must be less than %d", maxBitLength)
+	}
+
+	return nil
+}
@@ -0,0 +1,491 @@
+package bigutil_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+)
+
+func TestUint256Bitwise(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			fn   func(i, j bigutil.Uint256) bigutil.Uint256
+			i    bigutil.Uint256
+			j    bigutil.Uint256
+			out  bigutil.Uint256
+		}{
+			{
+				"and",
+				bigutil.Uint256.And,
+				bigutil.Uint64ToUint256(0b1100),
+				bigutil.Uint64ToUint256(0b1010),
+				bigutil.Uint64ToUint256(0b1000),
+			},
+			{
+				"or",
+				bigutil.Uint256.Or,
+				bigutil.Uint64ToUint256(0b1100),
+				bigutil.Uint64ToUint256(0b1010),
+				bigutil.Uint64ToUint256(0b1110),
+			},
+			{
+				"xor",
+				bigutil.Uint256.Xor,
+				bigutil.Uint64ToUint256(0b1100),
+				bigutil.Uint64ToUint256(0b1010),
+				bigutil.Uint64ToUint256(0b0110),
+			},
+			{
+				"xor: max",
+				bigutil.Uint256.Xor,
+				bigutil.MaxUint256(),
+				bigutil.MaxUint256(),
+				bigutil.Zero(),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Zero(t, tc.fn(tc.i, tc.j).BigInt().Cmp(tc.out.BigInt()))
+			})
+		}
+	})
+}
+
+func TestUint256Not(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   bigutil.Uint256
+			out  bigutil.Uint256
+		}{
+			{
+				"zero",
+				bigutil.Zero(),
+				bigutil.MaxUint256(),
+			},
+			{
+				"max",
+				bigutil.MaxUint256(),
+				bigutil.Zero(),
+			},
+			{
+				"one",
+				bigutil.One(),
+				bigutil.MustHexToUint256("0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe"),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Zero(t, tc.in.Not().BigInt().Cmp(tc.out.BigInt()))
+			})
+		}
+	})
+}
+
+func TestUint256Shift(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			fn   func(i bigutil.Uint256, n uint) bigutil.Uint256
+			in   bigutil.Uint256
+			n    uint
+			out  bigutil.Uint256
+		}{
+			{
+				"lsh",
+				bigutil.Uint256.Lsh,
+				bigutil.Uint64ToUint256(0b11),
+				2,
+				bigutil.Uint64ToUint256(0b1100),
+			},
+			{
+				"lsh: truncated",
+				bigutil.Uint256.Lsh,
+				bigutil.MaxUint256(),
+				255,
+				bigutil.MustHexToUint256("0x8000000000000000000000000000000000000000000000000000000000000000"),
+			},
+			{
+				"lsh: 256",
+				bigutil.Uint256.Lsh,
+				bigutil.One(),
+				256,
+				bigutil.Zero(),
+			},
+			{
+				"rsh",
+				bigutil.Uint256.Rsh,
+				bigutil.Uint64ToUint256(0b1101),
+				2,
+				bigutil.Uint64ToUint256(0b11),
+			},
+			{
+				"rsh: 255",
+				bigutil.Uint256.Rsh,
+				bigutil.MaxUint256(),
+				255,
+				bigutil.One(),
+			},
+			{
+				"rsh: 256",
+				bigutil.Uint256.Rsh,
+				bigutil.MaxUint256(),
+				256,
+				bigutil.Zero(),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Zero(t, tc.fn(tc.in, tc.n).BigInt().Cmp(tc.out.BigInt()))
+			})
+		}
+	})
+}
+
+func TestUint256CheckedShift(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			fn   func(i bigutil.Uint256, n uint) (bigutil.Uint256, error)
+			in   bigutil.Uint256
+			n    uint
+			out  bigutil.Uint256
+		}{
+			{
+				"lsh",
+				bigutil.Uint256.CheckedLsh,
+				bigutil.One(),
+				255,
+				bigutil.MustHexToUint256("0x8000000000000000000000000000000000000000000000000000000000000000"),
+			},
+			{
+				"lsh: zero",
+				bigutil.Uint256.CheckedLsh,
+				bigutil.Zero(),
+				1000,
+				bigutil.Zero(),
+			},
+			{
+				"rsh",
+				bigutil.Uint256.CheckedRsh,
+				bigutil.Uint64ToUint256(0b1100),
+				2,
+				bigutil.Uint64ToUint256(0b11),
+			},
+			{
+				"rsh: zero",
+				bigutil.Uint256.CheckedRsh,
+				bigutil.Zero(),
+				1000,
+				bigutil.Zero(),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				out, err := tc.fn(tc.in, tc.n)
+				require.Nil(t, err)
+
+				require.Zero(t, out.BigInt().Cmp(tc.out.BigInt()))
+			})
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			fn   func(i bigutil.Uint256, n uint) (bigutil.Uint256, error)
+			in   bigutil.Uint256
+			n    uint
+		}{
+			{
+				"lsh",
+				bigutil.Uint256.CheckedLsh,
+				bigutil.Uint64ToUint256(0b10),
+				255,
+			},
+			{
+				"lsh: 256",
+				bigutil.Uint256.CheckedLsh,
+				bigutil.One(),
+				256,
+			},
+			{
+				"rsh",
+				bigutil.Uint256.CheckedRsh,
+				bigutil.Uint64ToUint256(0b110),
+				2,
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := tc.fn(tc.in, tc.n)
+				require.Error(t, err)
+			})
+		}
+	})
+}
+
+func TestUint256Log2(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   bigutil.Uint256
+			out  int
+		}{
+			{
+				"one",
+				bigutil.One(),
+				0,
+			},
+			{
+				"floor",
+				bigutil.Uint64ToUint256(1023),
+				9,
+			},
+			{
+				"power of two",
+				bigutil.Uint64ToUint256(1024),
+				10,
+			},
+			{
+				"max",
+				bigutil.MaxUint256(),
+				255,
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				out, err := tc.in.Log2()
+				require.Nil(t, err)
+
+				require.Equal(t, tc.out, out)
+			})
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		_, err := bigutil.Zero().Log2()
+		require.Error(t, err)
+	})
+}
+
+func TestUint256BitCounts(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name          string
+			in            bigutil.Uint256
+			leadingZeros  int
+			trailingZeros int
+			onesCount     int
+		}{
+			{
+				"zero",
+				bigutil.Zero(),
+				256,
+				256,
+				0,
+			},
+			{
+				"one",
+				bigutil.One(),
+				255,
+				0,
+				1,
+			},
+			{
+				"small",
+				bigutil.Uint64ToUint256(0b101100),
+				250,
+				2,
+				3,
+			},
+			{
+				"high bit",
+				bigutil.MustHexToUint256("0x8000000000000000000000000000000000000000000000000000000000000000"),
+				0,
+				255,
+				1,
+			},
+			{
+				"max",
+				bigutil.MaxUint256(),
+				0,
+				0,
+				256,
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Equal(t, tc.leadingZeros, tc.in.LeadingZeros())
+				require.Equal(t, tc.trailingZeros, tc.in.TrailingZeros())
+				require.Equal(t, tc.onesCount, tc.in.OnesCount())
+			})
+		}
+	})
+}
+
+func TestUint256IsPowerOfTwo(t *testing.T) {
+	require.False(t, bigutil.Zero().IsPowerOfTwo())
+	require.True(t, bigutil.One().IsPowerOfTwo())
+	require.True(t, bigutil.Uint64ToUint256(1024).IsPowerOfTwo())
+	require.False(t, bigutil.Uint64ToUint256(1023).IsPowerOfTwo())
+	require.True(t, bigutil.MustHexToUint256("0x8000000000000000000000000000000000000000000000000000000000000000").IsPowerOfTwo())
+	require.False(t, bigutil.MaxUint256().IsPowerOfTwo())
+}
+
+func TestUint256PowerOfTwo(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			fn   func(i bigutil.Uint256) (bigutil.Uint256, error)
+			in   bigutil.Uint256
+			out  bigutil.Uint256
+		}{
+			{
+				"next: zero",
+				bigutil.Uint256.NextPowerOfTwo,
+				bigutil.Zero(),
+				bigutil.One(),
+			},
+			{
+				"next: power of two",
+				bigutil.Uint256.NextPowerOfTwo,
+				bigutil.Uint64ToUint256(1024),
+				bigutil.Uint64ToUint256(1024),
+			},
+			{
+				"next",
+				bigutil.Uint256.NextPowerOfTwo,
+				bigutil.Uint64ToUint256(1025),
+				bigutil.Uint64ToUint256(2048),
+			},
+			{
+				"next: largest",
+				bigutil.Uint256.NextPowerOfTwo,
+				bigutil.MustHexToUint256("0x7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"),
+				bigutil.MustHexToUint256("0x8000000000000000000000000000000000000000000000000000000000000000"),
+			},
+			{
+				"prev: one",
+				bigutil.Uint256.PrevPowerOfTwo,
+				bigutil.One(),
+				bigutil.One(),
+			},
+			{
+				"prev",
+				bigutil.Uint256.PrevPowerOfTwo,
+				bigutil.Uint64ToUint256(1023),
+				bigutil.Uint64ToUint256(512),
+			},
+			{
+				"prev: max",
+				bigutil.Uint256.PrevPowerOfTwo,
+				bigutil.MaxUint256(),
+				bigutil.MustHexToUint256("0x8000000000000000000000000000000000000000000000000000000000000000"),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				out, err := tc.fn(tc.in)
+				require.Nil(t, err)
+
+				require.Zero(t, out.BigInt().Cmp(tc.out.BigInt()))
+			})
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			fn   func(i bigutil.Uint256) (bigutil.Uint256, error)
+			in   bigutil.Uint256
+		}{
+			{
+				"next: overflow",
+				bigutil.Uint256.NextPowerOfTwo,
+				bigutil.MustHexToUint256("0x8000000000000000000000000000000000000000000000000000000000000001"),
+			},
+			{
+				"prev: zero",
+				bigutil.Uint256.PrevPowerOfTwo,
+				bigutil.Zero(),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := tc.fn(tc.in)
+				require.Error(t, err)
+			})
+		}
+	})
+}
+
+func TestUint256Bit(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			n    uint
+			out  bigutil.Uint256
+		}{
+			{
+				"lowest",
+				0,
+				bigutil.One(),
+			},
+			{
+				"middle",
+				64,
+				bigutil.MustHexToUint256("0x10000000000000000"),
+			},
+			{
+				"highest",
+				255,
+				bigutil.MustHexToUint256("0x8000000000000000000000000000000000000000000000000000000000000000"),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var i bigutil.Uint256
+				require.Nil(t, i.SetBit(tc.n))
+
+				require.Zero(t, i.BigInt().Cmp(tc.out.BigInt()))
+
+				ok, err := i.TestBit(tc.n)
+				require.Nil(t, err)
+				require.True(t, ok)
+
+				require.Nil(t, i.ClearBit(tc.n))
+
+				require.True(t, i.IsZero())
+
+				ok, err = i.TestBit(tc.n)
+				require.Nil(t, err)
+				require.False(t, ok)
+			})
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		i := bigutil.One()
+		require.Error(t, i.SetBit(256))
+		require.Error(t, i.ClearBit(256))
+
+		_, err := i.TestBit(256)
+		require.Error(t, err)
+
+		require.True(t, i.EqualUint64(1))
+	})
+}
@@ -0,0 +1,64 @@
+package bigutil
+
+// Bloom is a Bloom filter over Uint256 keys.
+// Keys are expected to be uniformly distributed, e.g. hashes or addresses derived from hashes:
+// the bit indices are derived from the key itself rather than by hashing it again.
+// It is not safe for concurrent use.
+type Bloom struct {
+	words []uint64
+	m     uint64
+	k     int
+}
+
+// NewBloom returns a new empty Bloom filter of m bits that sets k bits per key.
+// It returns an error if m or k is zero.
+func NewBloom(m uint64, k int) (*Bloom, error) {
+	if m == 0 {
+		return nil, errorf("m must be positive")
+	}
+	if k <= 0 {
+		return nil, errorf("k must be positive")
+	}
+
+	return &Bloom{
+		words: make([]uint64, (m+63)/64),
+		m:     m,
+		k:     k,
+	}, nil
+}
+
+// Add adds the given key.
+func (b *Bloom) Add(x Uint256) {
+	h1, h2 := bloomHashes(x)
+	for j := range b.k {
+		idx := (h1 + uint64(j)*h2) % b.m
+		b.words[idx/64] |= 1 << (idx % 64)
+	}
+}
+
+// Contains reports whether the given key may have been added.
+// False positives are possible; false negatives are not.
+func (b *Bloom) Contains(x Uint256) bool {
+	h1, h2 := bloomHashes(x)
+	for j := range b.k {
+		idx := (h1 + uint64(j)*h2) % b.m
+		if b.words[idx/64]&(1<<(idx%64)) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Reset removes all keys.
+func (b *Bloom) Reset() {
+	clear(b.words)
+}
+
+// bloomHashes returns the two hashes for double hashing, taken from the words of x.
+// h2 is made odd so that the indices do not collapse when it would be zero.
+func bloomHashes(x Uint256) (uint64, uint64) {
+	ws := x.uint64s()
+
+	return ws[3] ^ ws[1], (ws[2] ^ ws[0]) | 1
+}
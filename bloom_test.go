@@ -0,0 +1,71 @@
+package bigutil_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+)
+
+func TestNewBloom(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			m    uint64
+			k    int
+		}{
+			{
+				"zero m",
+				0,
+				1,
+			},
+			{
+				"zero k",
+				1,
+				0,
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := bigutil.NewBloom(tc.m, tc.k)
+				require.Error(t, err)
+			})
+		}
+	})
+}
+
+func TestBloom(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		key := func(n uint64) bigutil.Uint256 {
+			var b [8]byte
+			binary.BigEndian.PutUint64(b[:], n)
+
+			return bigutil.Keccak256ToUint256(b[:])
+		}
+
+		// About 1% false positives for 1000 keys.
+		b, err := bigutil.NewBloom(9586, 7)
+		require.Nil(t, err)
+
+		for n := range uint64(1000) {
+			b.Add(key(n))
+		}
+		for n := range uint64(1000) {
+			require.True(t, b.Contains(key(n)))
+		}
+
+		falsePositives := 0
+		for n := uint64(1000); n < 11000; n++ {
+			if b.Contains(key(n)) {
+				falsePositives++
+			}
+		}
+		require.Less(t, falsePositives, 200)
+
+		b.Reset()
+		require.False(t, b.Contains(key(0)))
+	})
+}
@@ -0,0 +1,82 @@
+package bigutil
+
+// Calculator chains checked arithmetic on a Uint256 and keeps the first error,
+// so that a multi-step formula reads as one expression:
+//
+//	r, err := bigutil.Calc(x).Add(y).MulDiv(a, b).Result()
+//
+// Once an operation fails, the remaining ones are skipped.
+// The zero value is a calculator of zero.
+type Calculator struct {
+	x   Uint256
+	err error
+}
+
+// Calc returns a Calculator starting from x.
+func Calc(x Uint256) Calculator {
+	return Calculator{x: x}
+}
+
+// Add adds y as Uint256.Add does.
+func (c Calculator) Add(y Uint256) Calculator {
+	return c.apply(func(x Uint256) (Uint256, error) { return x.Add(y) })
+}
+
+// Sub subtracts y as Uint256.Sub does.
+func (c Calculator) Sub(y Uint256) Calculator {
+	return c.apply(func(x Uint256) (Uint256, error) { return x.Sub(y) })
+}
+
+// Mul multiplies by y as Uint256.Mul does.
+func (c Calculator) Mul(y Uint256) Calculator {
+	return c.apply(func(x Uint256) (Uint256, error) { return x.Mul(y) })
+}
+
+// Div divides by y as Uint256.Div does.
+func (c Calculator) Div(y Uint256) Calculator {
+	return c.apply(func(x Uint256) (Uint256, error) { return x.Div(y) })
+}
+
+// Mod takes the remainder by y as Uint256.Mod does.
+func (c Calculator) Mod(y Uint256) Calculator {
+	return c.apply(func(x Uint256) (Uint256, error) { return x.Mod(y) })
+}
+
+// Pow raises to exp as Uint256.Pow does.
+func (c Calculator) Pow(exp Uint256) Calculator {
+	return c.apply(func(x Uint256) (Uint256, error) { return x.Pow(exp) })
+}
+
+// MulDiv multiplies by a and divides by b at full precision, rounding down, as MulDiv does.
+func (c Calculator) MulDiv(a, b Uint256) Calculator {
+	return c.MulDivRound(a, b, RoundFloor)
+}
+
+// MulDivRound is like MulDiv, but rounds with the given mode.
+func (c Calculator) MulDivRound(a, b Uint256, rounding Rounding) Calculator {
+	return c.apply(func(x Uint256) (Uint256, error) { return MulDiv(x, a, b, rounding) })
+}
+
+// Result returns the result of the chain, or the first error that occurred in it.
+func (c Calculator) Result() (Uint256, error) {
+	if c.err != nil {
+		return Uint256{}, c.err
+	}
+
+	return c.x, nil
+}
+
+// Err returns the first error that occurred in the chain.
+func (c Calculator) Err() error {
+	return c.err
+}
+
+func (c Calculator) apply(op func(x Uint256) (Uint256, error)) Calculator {
+	if c.err != nil {
+		return c
+	}
+
+	x, err := op(c.x)
+
+	return Calculator{x, err}
+}
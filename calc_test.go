@@ -0,0 +1,83 @@
+package bigutil_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+)
+
+func TestCalc(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			calc bigutil.Calculator
+			out  bigutil.Uint256
+		}{
+			{
+				"add and mul div",
+				bigutil.Calc(bigutil.Uint64ToUint256(10)).Add(bigutil.Uint64ToUint256(5)).MulDiv(bigutil.Uint64ToUint256(2), bigutil.Uint64ToUint256(4)),
+				bigutil.Uint64ToUint256(7),
+			},
+			{
+				"mul div round",
+				bigutil.Calc(bigutil.Uint64ToUint256(15)).MulDivRound(bigutil.Uint64ToUint256(2), bigutil.Uint64ToUint256(4), bigutil.RoundCeil),
+				bigutil.Uint64ToUint256(8),
+			},
+			{
+				"all operations",
+				bigutil.Calc(bigutil.Uint64ToUint256(7)).Sub(bigutil.Uint64ToUint256(4)).Pow(bigutil.Uint64ToUint256(3)).Mul(bigutil.Uint64ToUint256(2)).Div(bigutil.Uint64ToUint256(5)).Mod(bigutil.Uint64ToUint256(7)),
+				bigutil.Uint64ToUint256(3),
+			},
+			{
+				"zero value",
+				bigutil.Calculator{}.Add(bigutil.One()),
+				bigutil.One(),
+			},
+			{
+				"full precision",
+				bigutil.Calc(bigutil.MaxUint256()).MulDiv(bigutil.MaxUint256(), bigutil.MaxUint256()),
+				bigutil.MaxUint256(),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				out, err := tc.calc.Result()
+				require.Nil(t, err)
+				require.Nil(t, tc.calc.Err())
+
+				require.Zero(t, out.BigInt().Cmp(tc.out.BigInt()))
+			})
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			calc bigutil.Calculator
+		}{
+			{
+				"overflow",
+				bigutil.Calc(bigutil.MaxUint256()).Add(bigutil.One()),
+			},
+			{
+				"underflow then recoverable",
+				bigutil.Calc(bigutil.Zero()).Sub(bigutil.One()).Add(bigutil.One()),
+			},
+			{
+				"division by zero",
+				bigutil.Calc(bigutil.One()).Div(bigutil.Zero()).Mul(bigutil.Zero()),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := tc.calc.Result()
+				require.Error(t, err)
+				require.Equal(t, err, tc.calc.Err())
+			})
+		}
+	})
+}
@@ -0,0 +1,269 @@
+package bigutil
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+)
+
+// SQLMode is the representation of Uint256 in SQL columns.
+type SQLMode int
+
+const (
+	// SQLModeBytes is the minimal big-endian bytes, as used by Value and Scan unless StrictScan is true.
+	SQLModeBytes SQLMode = iota
+	// SQLModeFixedBytes is exactly 32 big-endian bytes, e.g. for BINARY(32) columns.
+	SQLModeFixedBytes
+	// SQLModeDecimal is a decimal string, e.g. for NUMERIC(78) columns.
+	SQLModeDecimal
+)
+
+// Encoding is a representation of Uint256 selectable at runtime with Codec.Encode and Codec.Decode.
+type Encoding int
+
+const (
+	// EncodingText is the textual representation in the format of the Codec, as used by EncodeText.
+	EncodingText Encoding = iota
+	// EncodingJSON is the JSON representation, as used by EncodeJSON.
+	EncodingJSON
+	// EncodingBinary is the minimal big-endian bytes, as used by MarshalBinary.
+	EncodingBinary
+	// EncodingBytes32 is exactly 32 big-endian bytes, as returned by Bytes32.
+	EncodingBytes32
+	// EncodingBytes32LE is exactly 32 little-endian bytes, as returned by Bytes32LE.
+	EncodingBytes32LE
+)
+
+var encodingNames = [...]string{
+	EncodingText:      "text",
+	EncodingJSON:      "json",
+	EncodingBinary:    "binary",
+	EncodingBytes32:   "bytes32",
+	EncodingBytes32LE: "bytes32le",
+}
+
+// String implements the fmt.Stringer interface.
+func (e Encoding) String() string {
+	if e < 0 || int(e) >= len(encodingNames) {
+		return "invalid"
+	}
+
+	return encodingNames[e]
+}
+
+// ParseEncoding returns the Encoding of the given name, as returned by Encoding.String,
+// e.g. to select the representation from configuration.
+func ParseEncoding(s string) (Encoding, error) {
+	for e, name := range encodingNames {
+		if name == s {
+			return Encoding(e), nil
+		}
+	}
+
+	return 0, errorf("unknown encoding: %q", s)
+}
+
+// Codec is a set of encoding options for Uint256.
+// Unlike the package-level options, it can vary per call,
+// e.g. by attaching it to a context with ContextWithCodec.
+type Codec struct {
+	// Format is the format used by EncodeText and EncodeJSON.
+	Format Format
+	// StrictParsing has the same meaning as the package-level StrictParsing.
+	StrictParsing bool
+	// SQLMode is the representation used by EncodeSQL and DecodeSQL.
+	SQLMode SQLMode
+	// JSONNull has the same meaning as the package-level JSONNull.
+	JSONNull NullBehavior
+	// SQLNull has the same meaning as the package-level SQLNull.
+	SQLNull NullBehavior
+	// AcceptedFormats has the same meaning as the package-level AcceptedFormats.
+	AcceptedFormats FormatWhitelist
+}
+
+// DefaultCodec returns the Codec equivalent to the methods of Uint256 under the current package-level options.
+func DefaultCodec() Codec {
+	c := Codec{
+		Format:          FormatHex,
+		StrictParsing:   StrictParsing,
+		SQLMode:         SQLModeBytes,
+		JSONNull:        JSONNull,
+		SQLNull:         SQLNull,
+		AcceptedFormats: AcceptedFormats,
+	}
+	if StrictScan {
+		c.SQLMode = SQLModeFixedBytes
+	}
+
+	return c
+}
+
+type codecContextKey struct{}
+
+// ContextWithCodec returns a copy of ctx carrying the given Codec.
+func ContextWithCodec(ctx context.Context, c Codec) context.Context {
+	return context.WithValue(ctx, codecContextKey{}, c)
+}
+
+// CodecFromContext returns the Codec carried by ctx, or DefaultCodec if there is none.
+func CodecFromContext(ctx context.Context) Codec {
+	if c, ok := ctx.Value(codecContextKey{}).(Codec); ok {
+		return c
+	}
+
+	return DefaultCodec()
+}
+
+// EncodeText returns the textual representation of i in the format of c.
+func (c Codec) EncodeText(i Uint256) ([]byte, error) {
+	if c.Format != FormatHex && c.Format != FormatDecimal {
+		return nil, errorf("format must be valid")
+	}
+
+	return []byte(i.Text(c.Format)), nil
+}
+
+// EncodeJSON returns the JSON representation of i, a string in the format of c.
+func (c Codec) EncodeJSON(i Uint256) ([]byte, error) {
+	text, err := c.EncodeText(i)
+	if err != nil {
+		return nil, err
+	}
+
+	return strconv.AppendQuote(nil, string(text)), nil
+}
+
+// DecodeText sets i to the value of the given text, as Uint256.UnmarshalText does under the options of c.
+func (c Codec) DecodeText(i *Uint256, text []byte) error {
+	return i.unmarshalText(text, c)
+}
+
+// DecodeJSON sets i to the value of the given JSON, as Uint256.UnmarshalJSON does under the options of c.
+func (c Codec) DecodeJSON(i *Uint256, b []byte) error {
+	return i.unmarshalJSON(b, c)
+}
+
+// Encode returns the representation of x in the given encoding.
+func (c Codec) Encode(x Uint256, e Encoding) ([]byte, error) {
+	switch e {
+	case EncodingText:
+		return c.EncodeText(x)
+	case EncodingJSON:
+		return c.EncodeJSON(x)
+	case EncodingBinary:
+		return x.MarshalBinary()
+	case EncodingBytes32:
+		b := x.Bytes32()
+		return b[:], nil
+	case EncodingBytes32LE:
+		b := x.Bytes32LE()
+		return b[:], nil
+	default:
+		return nil, errorf("encoding must be valid")
+	}
+}
+
+// Decode returns the Uint256 represented by b in the given encoding.
+func (c Codec) Decode(b []byte, e Encoding) (Uint256, error) {
+	var x Uint256
+
+	var err error
+	switch e {
+	case EncodingText:
+		err = c.DecodeText(&x, b)
+	case EncodingJSON:
+		err = c.DecodeJSON(&x, b)
+	case EncodingBinary:
+		err = x.UnmarshalBinary(b)
+	case EncodingBytes32, EncodingBytes32LE:
+		if len(b) != maxByteLength {
+			return Uint256{}, errorf("must be %d bytes", maxByteLength)
+		}
+		if e == EncodingBytes32 {
+			err = x.SetBytes(b)
+		} else {
+			err = x.SetBytesLE(b)
+		}
+	default:
+		err = errorf("encoding must be valid")
+	}
+	if err != nil {
+		return Uint256{}, err
+	}
+
+	return x, nil
+}
+
+// EncodeSQL returns the SQL representation of i in the SQL mode of c.
+func (c Codec) EncodeSQL(i Uint256) (driver.Value, error) {
+	switch c.SQLMode {
+	case SQLModeBytes:
+		return i.value(false), nil
+	case SQLModeFixedBytes:
+		return i.value(true), nil
+	case SQLModeDecimal:
+		return i.x.String(), nil
+	default:
+		return nil, errorf("sql mode must be valid")
+	}
+}
+
+// DecodeSQL sets i to the value of the given SQL representation in the SQL mode of c.
+func (c Codec) DecodeSQL(i *Uint256, src any) error {
+	switch c.SQLMode {
+	case SQLModeBytes:
+		return i.scan(src, false, c.SQLNull)
+	case SQLModeFixedBytes:
+		return i.scan(src, true, c.SQLNull)
+	case SQLModeDecimal:
+		switch v := src.(type) {
+		case nil:
+			if i.setNull(c.SQLNull) {
+				return nil
+			}
+
+			return errorf("src must not be nil")
+		case string:
+			return c.DecodeText(i, []byte(v))
+		default:
+			b, ok := srcBytes(src)
+			if !ok {
+				return errorf("unexpected src type: %T%s", src, inputSuffix(truncateInput(fmt.Sprint(src))))
+			}
+
+			return c.DecodeText(i, b)
+		}
+	default:
+		return errorf("sql mode must be valid")
+	}
+}
+
+// Valuer returns a driver.Valuer that encodes i with c, to be passed as a query argument.
+func (c Codec) Valuer(i Uint256) driver.Valuer {
+	return codecValuer{c, i}
+}
+
+// Scanner returns a sql.Scanner that decodes into i with c, to be passed to sql.Rows.Scan.
+func (c Codec) Scanner(i *Uint256) sql.Scanner {
+	return codecScanner{c, i}
+}
+
+type codecValuer struct {
+	c Codec
+	i Uint256
+}
+
+func (v codecValuer) Value() (driver.Value, error) {
+	return v.c.EncodeSQL(v.i)
+}
+
+type codecScanner struct {
+	c Codec
+	i *Uint256
+}
+
+func (s codecScanner) Scan(src any) error {
+	return s.c.DecodeSQL(s.i, src)
+}
@@ -0,0 +1,339 @@
+package bigutil_test
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+)
+
+func TestDefaultCodec(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		require.Equal(t, bigutil.Codec{
+			Format:          bigutil.FormatHex,
+			StrictParsing:   false,
+			SQLMode:         bigutil.SQLModeBytes,
+			JSONNull:        bigutil.NullAsError,
+			SQLNull:         bigutil.NullAsError,
+			AcceptedFormats: bigutil.AcceptAny,
+		}, bigutil.DefaultCodec())
+	})
+
+	t.Run("strict scan", func(t *testing.T) {
+		bigutil.StrictScan = true
+		t.Cleanup(func() {
+			bigutil.StrictScan = false
+		})
+
+		require.Equal(t, bigutil.SQLModeFixedBytes, bigutil.DefaultCodec().SQLMode)
+	})
+}
+
+func TestCodecFromContext(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		require.Equal(t, bigutil.DefaultCodec(), bigutil.CodecFromContext(context.Background()))
+
+		c := bigutil.Codec{
+			Format: bigutil.FormatDecimal,
+		}
+		require.Equal(t, c, bigutil.CodecFromContext(bigutil.ContextWithCodec(context.Background(), c)))
+	})
+}
+
+func TestCodecEncodeJSON(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name   string
+			format bigutil.Format
+			out    string
+		}{
+			{
+				"hex",
+				bigutil.FormatHex,
+				`"0xff"`,
+			},
+			{
+				"decimal",
+				bigutil.FormatDecimal,
+				`"255"`,
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				b, err := bigutil.Codec{Format: tc.format}.EncodeJSON(bigutil.Uint64ToUint256(255))
+				require.Nil(t, err)
+
+				require.Equal(t, tc.out, string(b))
+			})
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		_, err := bigutil.Codec{Format: bigutil.Format(-1)}.EncodeJSON(bigutil.Uint64ToUint256(255))
+		require.Error(t, err)
+	})
+}
+
+func TestCodecDecodeJSON(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		var i bigutil.Uint256
+		require.Nil(t, bigutil.Codec{}.DecodeJSON(&i, []byte(`"0x00ff"`)))
+
+		require.True(t, i.EqualUint64(255))
+	})
+
+	t.Run("null as zero", func(t *testing.T) {
+		i := bigutil.One()
+		require.Nil(t, bigutil.Codec{JSONNull: bigutil.NullAsZero}.DecodeJSON(&i, []byte(`null`)))
+
+		require.True(t, i.EqualUint64(0))
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		var i bigutil.Uint256
+		require.Error(t, bigutil.Codec{StrictParsing: true}.DecodeJSON(&i, []byte(`"0x00ff"`)))
+		require.Error(t, bigutil.Codec{}.DecodeJSON(&i, []byte(`null`)))
+		require.Error(t, bigutil.Codec{AcceptedFormats: bigutil.AcceptDecimalOnly}.DecodeJSON(&i, []byte(`"0xff"`)))
+	})
+}
+
+func TestCodecEncodeSQL(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			mode bigutil.SQLMode
+			out  driver.Value
+		}{
+			{
+				"bytes",
+				bigutil.SQLModeBytes,
+				[]byte{0xff},
+			},
+			{
+				"fixed bytes",
+				bigutil.SQLModeFixedBytes,
+				append(make([]byte, 31), 0xff),
+			},
+			{
+				"decimal",
+				bigutil.SQLModeDecimal,
+				"255",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				c := bigutil.Codec{SQLMode: tc.mode}
+
+				v, err := c.Valuer(bigutil.Uint64ToUint256(255)).Value()
+				require.Nil(t, err)
+				require.Equal(t, tc.out, v)
+
+				var i bigutil.Uint256
+				require.Nil(t, c.Scanner(&i).Scan(v))
+				require.True(t, i.EqualUint64(255))
+			})
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		_, err := bigutil.Codec{SQLMode: bigutil.SQLMode(-1)}.EncodeSQL(bigutil.Uint64ToUint256(255))
+		require.Error(t, err)
+	})
+}
+
+func TestCodecDecodeSQL(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		var i bigutil.Uint256
+		require.Nil(t, bigutil.Codec{SQLMode: bigutil.SQLModeDecimal}.DecodeSQL(&i, []byte("255")))
+
+		require.True(t, i.EqualUint64(255))
+	})
+
+	t.Run("null as zero", func(t *testing.T) {
+		for _, mode := range []bigutil.SQLMode{bigutil.SQLModeBytes, bigutil.SQLModeFixedBytes, bigutil.SQLModeDecimal} {
+			i := bigutil.One()
+			require.Nil(t, bigutil.Codec{SQLMode: mode, SQLNull: bigutil.NullAsZero}.DecodeSQL(&i, nil))
+
+			require.True(t, i.EqualUint64(0))
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			mode bigutil.SQLMode
+			in   any
+		}{
+			{
+				"fixed bytes: short",
+				bigutil.SQLModeFixedBytes,
+				[]byte{0xff},
+			},
+			{
+				"decimal: nil",
+				bigutil.SQLModeDecimal,
+				nil,
+			},
+			{
+				"decimal: unexpected type",
+				bigutil.SQLModeDecimal,
+				int64(255),
+			},
+			{
+				"decimal: negative",
+				bigutil.SQLModeDecimal,
+				"-1",
+			},
+			{
+				"invalid mode",
+				bigutil.SQLMode(-1),
+				[]byte{0xff},
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var i bigutil.Uint256
+				require.Error(t, bigutil.Codec{SQLMode: tc.mode}.DecodeSQL(&i, tc.in))
+			})
+		}
+	})
+}
+
+func TestParseEncoding(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		for _, e := range []bigutil.Encoding{
+			bigutil.EncodingText,
+			bigutil.EncodingJSON,
+			bigutil.EncodingBinary,
+			bigutil.EncodingBytes32,
+			bigutil.EncodingBytes32LE,
+		} {
+			out, err := bigutil.ParseEncoding(e.String())
+			require.Nil(t, err)
+			require.Equal(t, e, out)
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		_, err := bigutil.ParseEncoding("invalid")
+		require.Error(t, err)
+
+		require.Equal(t, "invalid", bigutil.Encoding(-1).String())
+	})
+}
+
+func TestCodecEncode(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		x := bigutil.MustHexToUint256("0x102")
+
+		tcs := []struct {
+			name     string
+			codec    bigutil.Codec
+			encoding bigutil.Encoding
+			out      []byte
+		}{
+			{
+				"text: hex",
+				bigutil.Codec{},
+				bigutil.EncodingText,
+				[]byte("0x102"),
+			},
+			{
+				"text: decimal",
+				bigutil.Codec{Format: bigutil.FormatDecimal},
+				bigutil.EncodingText,
+				[]byte("258"),
+			},
+			{
+				"json",
+				bigutil.Codec{},
+				bigutil.EncodingJSON,
+				[]byte(`"0x102"`),
+			},
+			{
+				"binary",
+				bigutil.Codec{},
+				bigutil.EncodingBinary,
+				[]byte{0x01, 0x02},
+			},
+			{
+				"bytes32",
+				bigutil.Codec{},
+				bigutil.EncodingBytes32,
+				append(make([]byte, 30), 0x01, 0x02),
+			},
+			{
+				"bytes32le",
+				bigutil.Codec{},
+				bigutil.EncodingBytes32LE,
+				append([]byte{0x02, 0x01}, make([]byte, 30)...),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				b, err := tc.codec.Encode(x, tc.encoding)
+				require.Nil(t, err)
+				require.Equal(t, tc.out, b)
+
+				out, err := tc.codec.Decode(b, tc.encoding)
+				require.Nil(t, err)
+				require.Equal(t, x.String(), out.String())
+			})
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		_, err := bigutil.Codec{}.Encode(bigutil.One(), bigutil.Encoding(-1))
+		require.Error(t, err)
+	})
+}
+
+func TestCodecDecode(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name     string
+			in       []byte
+			encoding bigutil.Encoding
+		}{
+			{
+				"text",
+				[]byte("0xg"),
+				bigutil.EncodingText,
+			},
+			{
+				"binary: too long",
+				make([]byte, 33),
+				bigutil.EncodingBinary,
+			},
+			{
+				"bytes32: short",
+				make([]byte, 31),
+				bigutil.EncodingBytes32,
+			},
+			{
+				"bytes32le: short",
+				make([]byte, 31),
+				bigutil.EncodingBytes32LE,
+			},
+			{
+				"invalid encoding",
+				[]byte{},
+				bigutil.Encoding(-1),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := bigutil.Codec{}.Decode(tc.in, tc.encoding)
+				require.Error(t, err)
+			})
+		}
+	})
+}
@@ -0,0 +1,90 @@
+package bigutil
+
+import (
+	"math/big"
+)
+
+// Cmp compares i and j and returns -1 if i < j, 0 if i == j and +1 if i > j.
+func (i Uint256) Cmp(j Uint256) int {
+	return i.x.Cmp(&j.x)
+}
+
+// Equal reports whether i == j.
+func (i Uint256) Equal(j Uint256) bool {
+	return i.Cmp(j) == 0
+}
+
+// Lt reports whether i < j.
+func (i Uint256) Lt(j Uint256) bool {
+	return i.Cmp(j) < 0
+}
+
+// Lte reports whether i <= j.
+func (i Uint256) Lte(j Uint256) bool {
+	return i.Cmp(j) <= 0
+}
+
+// Gt reports whether i > j.
+func (i Uint256) Gt(j Uint256) bool {
+	return i.Cmp(j) > 0
+}
+
+// Gte reports whether i >= j.
+func (i Uint256) Gte(j Uint256) bool {
+	return i.Cmp(j) >= 0
+}
+
+// Clamp returns lo if i < lo, hi if i > hi, and i otherwise.
+// It panics if lo > hi.
+func (i Uint256) Clamp(lo, hi Uint256) Uint256 {
+	if lo.Gt(hi) {
+		panic("lo must be less than or equal to hi")
+	}
+
+	switch {
+	case i.Lt(lo):
+		return lo
+	case i.Gt(hi):
+		return hi
+	default:
+		return i
+	}
+}
+
+// InRange reports whether lo <= i <= hi.
+func (i Uint256) InRange(lo, hi Uint256) bool {
+	return i.Gte(lo) && i.Lte(hi)
+}
+
+// CmpUint64 compares i and u and returns -1 if i < u, 0 if i == u and +1 if i > u.
+// Unlike converting u to Uint256 first, it does not allocate.
+func (i Uint256) CmpUint64(u uint64) int {
+	if i.x.BitLen() > 64 {
+		return 1
+	}
+
+	switch v := i.x.Uint64(); {
+	case v < u:
+		return -1
+	case v > u:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// EqualUint64 reports whether i == u.
+func (i Uint256) EqualUint64(u uint64) bool {
+	return i.CmpUint64(u) == 0
+}
+
+// GreaterThanUint64 reports whether i > u.
+func (i Uint256) GreaterThanUint64(u uint64) bool {
+	return i.CmpUint64(u) > 0
+}
+
+// CmpBig compares i and x and returns -1 if i < x, 0 if i == x and +1 if i > x.
+// x may be any big.Int, including negative ones and ones wider than 256 bits, but must not be nil.
+func (i Uint256) CmpBig(x *big.Int) int {
+	return i.x.Cmp(x)
+}
@@ -0,0 +1,229 @@
+package bigutil_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+)
+
+func TestUint256Cmp(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			i    bigutil.Uint256
+			j    bigutil.Uint256
+			out  int
+		}{
+			{
+				"less",
+				bigutil.Zero(),
+				bigutil.One(),
+				-1,
+			},
+			{
+				"equal",
+				bigutil.MaxUint256(),
+				bigutil.MaxUint256(),
+				0,
+			},
+			{
+				"greater",
+				bigutil.MaxUint256(),
+				bigutil.Uint64ToUint256(math.MaxUint64),
+				1,
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Equal(t, tc.out, tc.i.Cmp(tc.j))
+				require.Equal(t, tc.out == 0, tc.i.Equal(tc.j))
+				require.Equal(t, tc.out < 0, tc.i.Lt(tc.j))
+				require.Equal(t, tc.out <= 0, tc.i.Lte(tc.j))
+				require.Equal(t, tc.out > 0, tc.i.Gt(tc.j))
+				require.Equal(t, tc.out >= 0, tc.i.Gte(tc.j))
+			})
+		}
+	})
+
+	t.Run("no allocation", func(t *testing.T) {
+		i, j := bigutil.MaxUint256(), bigutil.One()
+
+		require.Zero(t, testing.AllocsPerRun(100, func() {
+			_ = i.Lt(j)
+		}))
+	})
+}
+
+func TestUint256Clamp(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name    string
+			in      bigutil.Uint256
+			lo      bigutil.Uint256
+			hi      bigutil.Uint256
+			out     bigutil.Uint256
+			inRange bool
+		}{
+			{
+				"below",
+				bigutil.One(),
+				bigutil.Uint64ToUint256(10),
+				bigutil.Uint64ToUint256(20),
+				bigutil.Uint64ToUint256(10),
+				false,
+			},
+			{
+				"lower bound",
+				bigutil.Uint64ToUint256(10),
+				bigutil.Uint64ToUint256(10),
+				bigutil.Uint64ToUint256(20),
+				bigutil.Uint64ToUint256(10),
+				true,
+			},
+			{
+				"within",
+				bigutil.Uint64ToUint256(15),
+				bigutil.Uint64ToUint256(10),
+				bigutil.Uint64ToUint256(20),
+				bigutil.Uint64ToUint256(15),
+				true,
+			},
+			{
+				"upper bound",
+				bigutil.Uint64ToUint256(20),
+				bigutil.Uint64ToUint256(10),
+				bigutil.Uint64ToUint256(20),
+				bigutil.Uint64ToUint256(20),
+				true,
+			},
+			{
+				"above",
+				bigutil.MaxUint256(),
+				bigutil.Uint64ToUint256(10),
+				bigutil.Uint64ToUint256(20),
+				bigutil.Uint64ToUint256(20),
+				false,
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.True(t, tc.in.Clamp(tc.lo, tc.hi).Equal(tc.out))
+				require.Equal(t, tc.inRange, tc.in.InRange(tc.lo, tc.hi))
+			})
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		require.Panics(t, func() {
+			bigutil.One().Clamp(bigutil.Uint64ToUint256(2), bigutil.One())
+		})
+
+		require.False(t, bigutil.One().InRange(bigutil.Uint64ToUint256(2), bigutil.One()))
+	})
+}
+
+func TestUint256CmpUint64(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			i    bigutil.Uint256
+			u    uint64
+			out  int
+		}{
+			{
+				"zero: equal",
+				bigutil.Zero(),
+				0,
+				0,
+			},
+			{
+				"zero: less",
+				bigutil.Zero(),
+				1,
+				-1,
+			},
+			{
+				"max uint64: equal",
+				bigutil.Uint64ToUint256(math.MaxUint64),
+				math.MaxUint64,
+				0,
+			},
+			{
+				"max uint64: greater",
+				bigutil.Uint64ToUint256(math.MaxUint64),
+				math.MaxUint64 - 1,
+				1,
+			},
+			{
+				"more than 64 bits",
+				bigutil.MustHexToUint256("0x10000000000000000"),
+				math.MaxUint64,
+				1,
+			},
+			{
+				"max",
+				bigutil.MaxUint256(),
+				0,
+				1,
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Equal(t, tc.out, tc.i.CmpUint64(tc.u))
+				require.Equal(t, tc.out == 0, tc.i.EqualUint64(tc.u))
+				require.Equal(t, tc.out > 0, tc.i.GreaterThanUint64(tc.u))
+			})
+		}
+	})
+}
+
+func TestUint256CmpBig(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			i    bigutil.Uint256
+			x    *big.Int
+			out  int
+		}{
+			{
+				"equal",
+				bigutil.Uint64ToUint256(1),
+				big.NewInt(1),
+				0,
+			},
+			{
+				"negative",
+				bigutil.Zero(),
+				big.NewInt(-1),
+				1,
+			},
+			{
+				"wider than 256 bits",
+				bigutil.MaxUint256(),
+				new(big.Int).Lsh(big.NewInt(1), 256),
+				-1,
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Equal(t, tc.out, tc.i.CmpBig(tc.x))
+			})
+		}
+	})
+}
+
+func BenchmarkUint256CmpUint64(b *testing.B) {
+	i := bigutil.MustHexToUint256("0x5208")
+
+	for range b.N {
+		_ = i.CmpUint64(21000)
+	}
+}
@@ -0,0 +1,32 @@
+package bigutil
+
+import (
+	"crypto/sha256"
+	"hash"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// Keccak256ToUint256 returns the Keccak-256 digest of the given data, interpreted as a big-endian Uint256.
+func Keccak256ToUint256(data ...[]byte) Uint256 {
+	return digestToUint256(sha3.NewLegacyKeccak256(), data)
+}
+
+// SHA256ToUint256 returns the SHA-256 digest of the given data, interpreted as a big-endian Uint256.
+func SHA256ToUint256(data ...[]byte) Uint256 {
+	return digestToUint256(sha256.New(), data)
+}
+
+func digestToUint256(h hash.Hash, data [][]byte) Uint256 {
+	for _, b := range data {
+		h.Write(b)
+	}
+
+	var b [maxByteLength]byte
+	h.Sum(b[:0])
+
+	i := Uint256{}
+	i.x.SetBytes(b[:])
+
+	return i
+}
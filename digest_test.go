@@ -0,0 +1,59 @@
+package bigutil_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+)
+
+func TestKeccak256ToUint256(t *testing.T) {
+	tcs := []struct {
+		name string
+		in   [][]byte
+		out  string
+	}{
+		{
+			"empty",
+			nil,
+			"0xc5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470",
+		},
+		{
+			"multiple",
+			[][]byte{[]byte("hello "), []byte("world")},
+			"0x47173285a8d7341e5e972fc677286384f802f8ef42a5ec5f03bbfa254cb01fad",
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.out, bigutil.Keccak256ToUint256(tc.in...).String())
+		})
+	}
+}
+
+func TestSHA256ToUint256(t *testing.T) {
+	tcs := []struct {
+		name string
+		in   [][]byte
+		out  string
+	}{
+		{
+			"empty",
+			nil,
+			"0xe3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+		},
+		{
+			"multiple",
+			[][]byte{[]byte("hello "), []byte("world")},
+			"0xb94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9",
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.out, bigutil.SHA256ToUint256(tc.in...).String())
+		})
+	}
+}
@@ -0,0 +1,11 @@
+//go:build !tinygo
+
+package bigutil
+
+import (
+	"github.com/samber/oops"
+)
+
+func errorf(format string, args ...any) error {
+	return oops.Errorf(format, args...)
+}
@@ -0,0 +1,12 @@
+//go:build tinygo
+
+package bigutil
+
+import (
+	"fmt"
+)
+
+// errorf avoids samber/oops, whose dependency tree does not compile under TinyGo.
+func errorf(format string, args ...any) error {
+	return fmt.Errorf(format, args...)
+}
@@ -0,0 +1,264 @@
+package bigutil
+
+import (
+	"math/big"
+)
+
+const (
+	// maxEvalBitLength is the maximum bit length of the intermediate results of Eval.
+	maxEvalBitLength = 2 * maxBitLength
+	// maxEvalDepth is the maximum nesting depth of parentheses and ** in Eval,
+	// which bounds the recursion of the parser.
+	maxEvalDepth = 100
+)
+
+// Eval evaluates the given arithmetic expression, e.g. "2**256 - 1" or "0xff * 3 + 10", and returns the result.
+//
+// The expression consists of decimal and 0x-prefixed hex literals, the binary operators
+// + - * / % and ** (exponentiation), and parentheses.
+// ** binds tightest and is right-associative, followed by * / %, followed by + -; all others are left-associative.
+// / and % round towards zero.
+//
+// The expression is evaluated exactly, so only the final result must represent uint256;
+// intermediate results may be negative or exceed 256 bits, up to 512 bits.
+// It returns an error for a malformed expression, an expression nested more than 100 levels deep,
+// a division by zero, an intermediate result that is too large, or a final result that does not represent uint256.
+func Eval(expr string) (Uint256, error) {
+	e := evaluator{expr: expr}
+
+	x, err := e.parseSum()
+	if err != nil {
+		return Uint256{}, err
+	}
+
+	e.skipSpaces()
+	if e.pos < len(e.expr) {
+		return Uint256{}, e.unexpected()
+	}
+
+	return BigIntToUint256(x)
+}
+
+type evaluator struct {
+	expr  string
+	pos   int
+	depth int
+}
+
+func (e *evaluator) parseSum() (*big.Int, error) {
+	x, err := e.parseProduct()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		var op byte
+		switch {
+		case e.consume("+"):
+			op = '+'
+		case e.consume("-"):
+			op = '-'
+		default:
+			return x, nil
+		}
+
+		y, err := e.parseProduct()
+		if err != nil {
+			return nil, err
+		}
+
+		if op == '+' {
+			x.Add(x, y)
+		} else {
+			x.Sub(x, y)
+		}
+		if err := checkEvalBitLength(x); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (e *evaluator) parseProduct() (*big.Int, error) {
+	x, err := e.parsePower()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		var op byte
+		switch {
+		case e.peek("**"):
+			return x, nil
+		case e.consume("*"):
+			op = '*'
+		case e.consume("/"):
+			op = '/'
+		case e.consume("%"):
+			op = '%'
+		default:
+			return x, nil
+		}
+
+		y, err := e.parsePower()
+		if err != nil {
+			return nil, err
+		}
+
+		switch op {
+		case '*':
+			x.Mul(x, y)
+		case '/', '%':
+			if y.Sign() == 0 {
+				return nil, errorf("divisor must not be zero")
+			}
+			if op == '/' {
+				x.Quo(x, y)
+			} else {
+				x.Rem(x, y)
+			}
+		}
+		if err := checkEvalBitLength(x); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (e *evaluator) parsePower() (*big.Int, error) {
+	x, err := e.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	if !e.consume("**") {
+		return x, nil
+	}
+
+	if err := e.enter(); err != nil {
+		return nil, err
+	}
+	y, err := e.parsePower()
+	e.depth--
+	if err != nil {
+		return nil, err
+	}
+	if y.Sign() < 0 {
+		return nil, errorf("exponent must not be negative")
+	}
+
+	// Any base other than -1, 0 and 1 exceeds the limit at this exponent, so the big.Int is kept small.
+	if x.CmpAbs(big.NewInt(1)) > 0 && y.Cmp(big.NewInt(maxEvalBitLength)) >= 0 {
+		return nil, errorf("intermediate result must be less than or equal to %d bits", maxEvalBitLength)
+	}
+
+	x.Exp(x, y, nil)
+	if err := checkEvalBitLength(x); err != nil {
+		return nil, err
+	}
+
+	return x, nil
+}
+
+func (e *evaluator) parsePrimary() (*big.Int, error) {
+	e.skipSpaces()
+	if e.pos == len(e.expr) {
+		return nil, errorf("expression must not end at offset %d", e.pos)
+	}
+
+	if e.consume("(") {
+		if err := e.enter(); err != nil {
+			return nil, err
+		}
+		x, err := e.parseSum()
+		e.depth--
+		if err != nil {
+			return nil, err
+		}
+		if !e.consume(")") {
+			return nil, e.unexpected()
+		}
+
+		return x, nil
+	}
+
+	start := e.pos
+	for e.pos < len(e.expr) && isLiteralByte(e.expr[e.pos]) {
+		e.pos++
+	}
+	if start == e.pos {
+		return nil, e.unexpected()
+	}
+
+	lit := e.expr[start:e.pos]
+	if len(lit) >= 2 && lit[0] == '0' && lit[1] == 'x' {
+		x, err := decodeHex(lit, true)
+		if err != nil {
+			return nil, errorf("invalid literal at offset %d: %w", start, err)
+		}
+
+		return x, nil
+	}
+
+	x, ok := new(big.Int).SetString(lit, 10)
+	if !ok {
+		return nil, errorf("invalid literal at offset %d: %q", start, lit)
+	}
+	if err := Validate(x); err != nil {
+		return nil, errorf("invalid literal at offset %d: %w", start, err)
+	}
+
+	return x, nil
+}
+
+// enter increases the nesting depth, and returns an error if it exceeds maxEvalDepth.
+func (e *evaluator) enter() error {
+	if e.depth == maxEvalDepth {
+		return errorf("expression must be nested at most %d levels at offset %d", maxEvalDepth, e.pos)
+	}
+
+	e.depth++
+
+	return nil
+}
+
+// consume skips spaces and the given token, and reports whether the token was found.
+func (e *evaluator) consume(tok string) bool {
+	if !e.peek(tok) {
+		return false
+	}
+
+	e.pos += len(tok)
+
+	return true
+}
+
+// peek skips spaces and reports whether the given token follows.
+func (e *evaluator) peek(tok string) bool {
+	e.skipSpaces()
+
+	return len(e.expr)-e.pos >= len(tok) && e.expr[e.pos:e.pos+len(tok)] == tok
+}
+
+func (e *evaluator) skipSpaces() {
+	for e.pos < len(e.expr) && (e.expr[e.pos] == ' ' || e.expr[e.pos] == '\t') {
+		e.pos++
+	}
+}
+
+func (e *evaluator) unexpected() error {
+	if e.pos == len(e.expr) {
+		return errorf("expression must not end at offset %d", e.pos)
+	}
+
+	return errorf("unexpected %q at offset %d", e.expr[e.pos], e.pos)
+}
+
+func isLiteralByte(c byte) bool {
+	return '0' <= c && c <= '9' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || c == '_'
+}
+
+func checkEvalBitLength(x *big.Int) error {
+	if x.BitLen() > maxEvalBitLength {
+		return errorf("intermediate result must be less than or equal to %d bits", maxEvalBitLength)
+	}
+
+	return nil
+}
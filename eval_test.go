@@ -0,0 +1,156 @@
+package bigutil_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+)
+
+func TestEval(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   string
+			out  bigutil.Uint256
+		}{
+			{
+				"max",
+				"2**256 - 1",
+				bigutil.MaxUint256(),
+			},
+			{
+				"hex",
+				"0xff * 3 + 10",
+				bigutil.Uint64ToUint256(775),
+			},
+			{
+				"precedence",
+				"1 + 2 * 3 ** 2",
+				bigutil.Uint64ToUint256(19),
+			},
+			{
+				"right associative power",
+				"2 ** 3 ** 2",
+				bigutil.Uint64ToUint256(512),
+			},
+			{
+				"deepest parentheses",
+				strings.Repeat("(", 100) + "1" + strings.Repeat(")", 100),
+				bigutil.One(),
+			},
+			{
+				"left associative",
+				"100 / 10 / 5 - 1 - 1",
+				bigutil.Zero(),
+			},
+			{
+				"parentheses",
+				"(1 + 2) * (3 + 4) % 5",
+				bigutil.One(),
+			},
+			{
+				"negative intermediate",
+				"1 - 2 + 3",
+				bigutil.Uint64ToUint256(2),
+			},
+			{
+				"decimals",
+				"1000 * 10**18",
+				bigutil.MustHexToUint256("0x3635c9adc5dea00000"),
+			},
+			{
+				"no spaces",
+				"2**8-1",
+				bigutil.Uint64ToUint256(255),
+			},
+			{
+				"large exponent of one",
+				"1 ** (2**256 - 1)",
+				bigutil.One(),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				out, err := bigutil.Eval(tc.in)
+				require.Nil(t, err)
+
+				require.Zero(t, out.BigInt().Cmp(tc.out.BigInt()))
+			})
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   string
+		}{
+			{
+				"empty",
+				"",
+			},
+			{
+				"trailing operator",
+				"1 +",
+			},
+			{
+				"unbalanced parentheses",
+				"(1 + 2",
+			},
+			{
+				"trailing token",
+				"1 2",
+			},
+			{
+				"invalid literal",
+				"0xzz",
+			},
+			{
+				"unknown identifier",
+				"max",
+			},
+			{
+				"too large literal",
+				"0x10000000000000000000000000000000000000000000000000000000000000000",
+			},
+			{
+				"negative result",
+				"1 - 2",
+			},
+			{
+				"overflow",
+				"2**256",
+			},
+			{
+				"too large intermediate",
+				"2**600 - 2**600",
+			},
+			{
+				"division by zero",
+				"1 / (1 - 1)",
+			},
+			{
+				"negative exponent",
+				"2 ** (0 - 1)",
+			},
+			{
+				"too deep parentheses",
+				strings.Repeat("(", 101) + "1" + strings.Repeat(")", 101),
+			},
+			{
+				"too deep exponentiation",
+				"1" + strings.Repeat(" ** 1", 101),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := bigutil.Eval(tc.in)
+				require.Error(t, err)
+			})
+		}
+	})
+}
@@ -0,0 +1,185 @@
+// Package evm256 implements the arithmetic, comparison and bitwise opcodes of the EVM over bigutil.Uint256,
+// following the semantics defined in the Ethereum Yellow Paper.
+// The operands are in the order they are popped from the stack.
+package evm256
+
+import (
+	"math/big"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+)
+
+var (
+	one        = big.NewInt(1)
+	signBit    = new(big.Int).Lsh(one, 255)
+	maxUint256 = bigutil.MaxUint256()
+)
+
+// Add implements ADD: (a + b) mod 2^256.
+func Add(a, b bigutil.Uint256) bigutil.Uint256 {
+	return a.WrappingAdd(b)
+}
+
+// Mul implements MUL: (a * b) mod 2^256.
+func Mul(a, b bigutil.Uint256) bigutil.Uint256 {
+	return a.WrappingMul(b)
+}
+
+// Sub implements SUB: (a - b) mod 2^256.
+func Sub(a, b bigutil.Uint256) bigutil.Uint256 {
+	return a.WrappingSub(b)
+}
+
+// Div implements DIV: floor(a / b), or 0 if b is 0.
+func Div(a, b bigutil.Uint256) bigutil.Uint256 {
+	if b.BigIntUnsafe().Sign() == 0 {
+		return bigutil.Zero()
+	}
+
+	return wrap(new(big.Int).Quo(a.BigIntUnsafe(), b.BigIntUnsafe()))
+}
+
+// SDiv implements SDIV: a / b rounded toward zero as two's complement signed integers, or 0 if b is 0.
+// -2^255 / -1 overflows to -2^255.
+func SDiv(a, b bigutil.Uint256) bigutil.Uint256 {
+	if b.BigIntUnsafe().Sign() == 0 {
+		return bigutil.Zero()
+	}
+
+	return wrap(new(big.Int).Quo(signed(a), signed(b)))
+}
+
+// Mod implements MOD: a mod b, or 0 if b is 0.
+func Mod(a, b bigutil.Uint256) bigutil.Uint256 {
+	if b.BigIntUnsafe().Sign() == 0 {
+		return bigutil.Zero()
+	}
+
+	return wrap(new(big.Int).Rem(a.BigIntUnsafe(), b.BigIntUnsafe()))
+}
+
+// SMod implements SMOD: the signed remainder of a / b, which has the sign of a, or 0 if b is 0.
+func SMod(a, b bigutil.Uint256) bigutil.Uint256 {
+	if b.BigIntUnsafe().Sign() == 0 {
+		return bigutil.Zero()
+	}
+
+	return wrap(new(big.Int).Rem(signed(a), signed(b)))
+}
+
+// AddMod implements ADDMOD: (a + b) mod n without intermediate truncation, or 0 if n is 0.
+func AddMod(a, b, n bigutil.Uint256) bigutil.Uint256 {
+	return bigutil.AddMod(a, b, n)
+}
+
+// MulMod implements MULMOD: (a * b) mod n without intermediate truncation, or 0 if n is 0.
+func MulMod(a, b, n bigutil.Uint256) bigutil.Uint256 {
+	return bigutil.MulMod(a, b, n)
+}
+
+// Exp implements EXP: a^b mod 2^256.
+func Exp(a, b bigutil.Uint256) bigutil.Uint256 {
+	return a.WrappingPow(b)
+}
+
+// SignExtend implements SIGNEXTEND: extends the sign of the (b+1)-byte two's complement signed integer x.
+func SignExtend(b, x bigutil.Uint256) bigutil.Uint256 {
+	if b.BigIntUnsafe().Cmp(big.NewInt(31)) >= 0 {
+		return x.Clone()
+	}
+
+	bit := uint(b.BigIntUnsafe().Uint64()*8 + 7)
+	mask := new(big.Int).Sub(new(big.Int).Lsh(one, bit+1), one)
+
+	y := new(big.Int).And(x.BigIntUnsafe(), mask)
+	if y.Bit(int(bit)) == 1 {
+		y.Or(y, new(big.Int).Xor(maxUint256.BigIntUnsafe(), mask))
+	}
+
+	return wrap(y)
+}
+
+// Lt implements LT: 1 if a < b, and 0 otherwise.
+func Lt(a, b bigutil.Uint256) bigutil.Uint256 {
+	return boolean(a.BigIntUnsafe().Cmp(b.BigIntUnsafe()) < 0)
+}
+
+// Gt implements GT: 1 if a > b, and 0 otherwise.
+func Gt(a, b bigutil.Uint256) bigutil.Uint256 {
+	return boolean(a.BigIntUnsafe().Cmp(b.BigIntUnsafe()) > 0)
+}
+
+// Slt implements SLT: 1 if a < b as two's complement signed integers, and 0 otherwise.
+func Slt(a, b bigutil.Uint256) bigutil.Uint256 {
+	return boolean(signed(a).Cmp(signed(b)) < 0)
+}
+
+// Sgt implements SGT: 1 if a > b as two's complement signed integers, and 0 otherwise.
+func Sgt(a, b bigutil.Uint256) bigutil.Uint256 {
+	return boolean(signed(a).Cmp(signed(b)) > 0)
+}
+
+// Byte implements BYTE: the i-th byte of x counting from the most significant one, or 0 if i >= 32.
+func Byte(i, x bigutil.Uint256) bigutil.Uint256 {
+	if i.BigIntUnsafe().Cmp(big.NewInt(32)) >= 0 {
+		return bigutil.Zero()
+	}
+
+	y := new(big.Int).Rsh(x.BigIntUnsafe(), uint(31-i.BigIntUnsafe().Uint64())*8)
+
+	return wrap(y.And(y, big.NewInt(0xff)))
+}
+
+// Shl implements SHL: (x << shift) mod 2^256.
+func Shl(shift, x bigutil.Uint256) bigutil.Uint256 {
+	if shift.BigIntUnsafe().Cmp(big.NewInt(256)) >= 0 {
+		return bigutil.Zero()
+	}
+
+	return wrap(new(big.Int).Lsh(x.BigIntUnsafe(), uint(shift.BigIntUnsafe().Uint64())))
+}
+
+// Shr implements SHR: the logical right shift of x by shift.
+func Shr(shift, x bigutil.Uint256) bigutil.Uint256 {
+	if shift.BigIntUnsafe().Cmp(big.NewInt(256)) >= 0 {
+		return bigutil.Zero()
+	}
+
+	return wrap(new(big.Int).Rsh(x.BigIntUnsafe(), uint(shift.BigIntUnsafe().Uint64())))
+}
+
+// Sar implements SAR: the arithmetic right shift of x by shift as a two's complement signed integer.
+func Sar(shift, x bigutil.Uint256) bigutil.Uint256 {
+	n := uint(256)
+	if shift.BigIntUnsafe().Cmp(big.NewInt(256)) < 0 {
+		n = uint(shift.BigIntUnsafe().Uint64())
+	}
+
+	return wrap(new(big.Int).Rsh(signed(x), n))
+}
+
+// signed interprets the given value as a two's complement signed integer.
+func signed(i bigutil.Uint256) *big.Int {
+	x := i.BigInt()
+	if x.Cmp(signBit) >= 0 {
+		// x - 2^256
+		x.Sub(x, maxUint256.BigIntUnsafe())
+		x.Sub(x, one)
+	}
+
+	return x
+}
+
+// wrap reduces the given big.Int modulo 2^256 and converts it to Uint256.
+// Masking works for negative values as well, since big.Int applies bitwise operations in two's complement.
+func wrap(x *big.Int) bigutil.Uint256 {
+	return bigutil.MustBigIntToUint256(x.And(x, maxUint256.BigIntUnsafe()))
+}
+
+func boolean(b bool) bigutil.Uint256 {
+	if b {
+		return bigutil.Uint64ToUint256(1)
+	}
+
+	return bigutil.Zero()
+}
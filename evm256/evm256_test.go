@@ -0,0 +1,421 @@
+package evm256_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+	"github.com/m0t0k1ch1-go/bigutil/v2/evm256"
+)
+
+const (
+	maxUint256 = "0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"
+	minus2     = "0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe"
+	minus3     = "0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffd"
+	minus10    = "0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff6"
+	signBit    = "0x8000000000000000000000000000000000000000000000000000000000000000"
+	maxInt     = "0x7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"
+)
+
+func TestBinaryOps(t *testing.T) {
+	tcs := []struct {
+		name string
+		op   func(a, b bigutil.Uint256) bigutil.Uint256
+		a    string
+		b    string
+		out  string
+	}{
+		{
+			"ADD",
+			evm256.Add,
+			"0x1",
+			"0x2",
+			"0x3",
+		},
+		{
+			"ADD overflow",
+			evm256.Add,
+			maxUint256,
+			"0x1",
+			"0x0",
+		},
+		{
+			"MUL",
+			evm256.Mul,
+			"0x3",
+			"0x4",
+			"0xc",
+		},
+		{
+			"MUL overflow",
+			evm256.Mul,
+			maxUint256,
+			maxUint256,
+			"0x1",
+		},
+		{
+			"SUB",
+			evm256.Sub,
+			"0x3",
+			"0x1",
+			"0x2",
+		},
+		{
+			"SUB underflow",
+			evm256.Sub,
+			"0x0",
+			"0x1",
+			maxUint256,
+		},
+		{
+			"DIV",
+			evm256.Div,
+			"0xa",
+			"0x3",
+			"0x3",
+		},
+		{
+			"DIV by zero",
+			evm256.Div,
+			"0xa",
+			"0x0",
+			"0x0",
+		},
+		{
+			"SDIV",
+			evm256.SDiv,
+			minus10,
+			"0x3",
+			minus3,
+		},
+		{
+			"SDIV overflow",
+			evm256.SDiv,
+			signBit,
+			maxUint256,
+			signBit,
+		},
+		{
+			"SDIV by zero",
+			evm256.SDiv,
+			minus10,
+			"0x0",
+			"0x0",
+		},
+		{
+			"MOD",
+			evm256.Mod,
+			"0xa",
+			"0x3",
+			"0x1",
+		},
+		{
+			"MOD by zero",
+			evm256.Mod,
+			"0xa",
+			"0x0",
+			"0x0",
+		},
+		{
+			"SMOD negative dividend",
+			evm256.SMod,
+			minus10,
+			"0x3",
+			maxUint256,
+		},
+		{
+			"SMOD negative divisor",
+			evm256.SMod,
+			"0xa",
+			minus3,
+			"0x1",
+		},
+		{
+			"SMOD by zero",
+			evm256.SMod,
+			minus10,
+			"0x0",
+			"0x0",
+		},
+		{
+			"EXP",
+			evm256.Exp,
+			"0x3",
+			"0x2",
+			"0x9",
+		},
+		{
+			"EXP to sign bit",
+			evm256.Exp,
+			"0x2",
+			"0xff",
+			signBit,
+		},
+		{
+			"EXP overflow",
+			evm256.Exp,
+			"0x2",
+			"0x100",
+			"0x0",
+		},
+		{
+			"SIGNEXTEND negative",
+			evm256.SignExtend,
+			"0x0",
+			"0xff",
+			maxUint256,
+		},
+		{
+			"SIGNEXTEND positive",
+			evm256.SignExtend,
+			"0x0",
+			"0x17f",
+			"0x7f",
+		},
+		{
+			"SIGNEXTEND two bytes",
+			evm256.SignExtend,
+			"0x1",
+			"0xfffe",
+			minus2,
+		},
+		{
+			"SIGNEXTEND full width",
+			evm256.SignExtend,
+			"0x1f",
+			"0xff",
+			"0xff",
+		},
+		{
+			"SIGNEXTEND huge",
+			evm256.SignExtend,
+			maxUint256,
+			"0xff",
+			"0xff",
+		},
+		{
+			"LT",
+			evm256.Lt,
+			"0x1",
+			"0x2",
+			"0x1",
+		},
+		{
+			"LT unsigned",
+			evm256.Lt,
+			maxUint256,
+			"0x0",
+			"0x0",
+		},
+		{
+			"GT",
+			evm256.Gt,
+			maxUint256,
+			"0x0",
+			"0x1",
+		},
+		{
+			"SLT",
+			evm256.Slt,
+			maxUint256,
+			"0x0",
+			"0x1",
+		},
+		{
+			"SLT equal",
+			evm256.Slt,
+			maxUint256,
+			maxUint256,
+			"0x0",
+		},
+		{
+			"SGT",
+			evm256.Sgt,
+			maxUint256,
+			"0x0",
+			"0x0",
+		},
+		{
+			"SGT positive",
+			evm256.Sgt,
+			maxInt,
+			signBit,
+			"0x1",
+		},
+		{
+			"BYTE last",
+			evm256.Byte,
+			"0x1f",
+			"0xabcd",
+			"0xcd",
+		},
+		{
+			"BYTE first",
+			evm256.Byte,
+			"0x0",
+			signBit,
+			"0x80",
+		},
+		{
+			"BYTE out of range",
+			evm256.Byte,
+			"0x20",
+			maxUint256,
+			"0x0",
+		},
+		{
+			"SHL",
+			evm256.Shl,
+			"0x1",
+			"0x1",
+			"0x2",
+		},
+		{
+			"SHL to sign bit",
+			evm256.Shl,
+			"0xff",
+			"0x1",
+			signBit,
+		},
+		{
+			"SHL truncation",
+			evm256.Shl,
+			"0x1",
+			maxUint256,
+			minus2,
+		},
+		{
+			"SHL out of range",
+			evm256.Shl,
+			"0x100",
+			"0x1",
+			"0x0",
+		},
+		{
+			"SHR",
+			evm256.Shr,
+			"0x1",
+			"0x2",
+			"0x1",
+		},
+		{
+			"SHR logical",
+			evm256.Shr,
+			"0xff",
+			maxUint256,
+			"0x1",
+		},
+		{
+			"SHR out of range",
+			evm256.Shr,
+			"0x100",
+			maxUint256,
+			"0x0",
+		},
+		{
+			"SAR negative",
+			evm256.Sar,
+			"0x1",
+			signBit,
+			"0xc000000000000000000000000000000000000000000000000000000000000000",
+		},
+		{
+			"SAR positive",
+			evm256.Sar,
+			"0x1",
+			maxInt,
+			"0x3fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+		},
+		{
+			"SAR negative out of range",
+			evm256.Sar,
+			"0x100",
+			signBit,
+			maxUint256,
+		},
+		{
+			"SAR positive out of range",
+			evm256.Sar,
+			maxUint256,
+			maxInt,
+			"0x0",
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			out := tc.op(bigutil.MustHexToUint256(tc.a), bigutil.MustHexToUint256(tc.b))
+
+			require.Equal(t, tc.out, out.String())
+		})
+	}
+}
+
+func TestTernaryOps(t *testing.T) {
+	tcs := []struct {
+		name string
+		op   func(a, b, n bigutil.Uint256) bigutil.Uint256
+		a    string
+		b    string
+		n    string
+		out  string
+	}{
+		{
+			"ADDMOD",
+			evm256.AddMod,
+			"0xa",
+			"0xa",
+			"0x8",
+			"0x4",
+		},
+		{
+			"ADDMOD without truncation",
+			evm256.AddMod,
+			maxUint256,
+			"0x2",
+			"0x2",
+			"0x1",
+		},
+		{
+			"ADDMOD by zero",
+			evm256.AddMod,
+			"0xa",
+			"0xa",
+			"0x0",
+			"0x0",
+		},
+		{
+			"MULMOD",
+			evm256.MulMod,
+			"0xa",
+			"0xa",
+			"0x8",
+			"0x4",
+		},
+		{
+			"MULMOD without truncation",
+			evm256.MulMod,
+			maxUint256,
+			maxUint256,
+			"0xc",
+			"0x9",
+		},
+		{
+			"MULMOD by zero",
+			evm256.MulMod,
+			"0xa",
+			"0xa",
+			"0x0",
+			"0x0",
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			out := tc.op(bigutil.MustHexToUint256(tc.a), bigutil.MustHexToUint256(tc.b), bigutil.MustHexToUint256(tc.n))
+
+			require.Equal(t, tc.out, out.String())
+		})
+	}
+}
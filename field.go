@@ -0,0 +1,52 @@
+package bigutil
+
+var (
+	bn254ScalarField    = MustHexToUint256("0x30644e72e131a029b85045b68181585d2833e84879b9709143e1f593f0000001")
+	bn254BaseField      = MustHexToUint256("0x30644e72e131a029b85045b68181585d97816a916871ca8d3c208c16d87cfd47")
+	bls12381ScalarField = MustHexToUint256("0x73eda753299d7d483339d80809a1d80553bda402fffe5bfeffffffff00000001")
+	secp256k1Order      = MustHexToUint256("0xfffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364141")
+	secp256k1BaseField  = MustHexToUint256("0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f")
+)
+
+// BN254ScalarField returns the order of the scalar field of the BN254 (alt_bn128) curve.
+func BN254ScalarField() Uint256 {
+	return bn254ScalarField.Clone()
+}
+
+// BN254BaseField returns the modulus of the base field of the BN254 (alt_bn128) curve.
+func BN254BaseField() Uint256 {
+	return bn254BaseField.Clone()
+}
+
+// BLS12381ScalarField returns the order of the scalar field of the BLS12-381 curve.
+func BLS12381ScalarField() Uint256 {
+	return bls12381ScalarField.Clone()
+}
+
+// Secp256k1Order returns the order of the secp256k1 curve.
+func Secp256k1Order() Uint256 {
+	return secp256k1Order.Clone()
+}
+
+// Secp256k1BaseField returns the modulus of the base field of the secp256k1 curve.
+func Secp256k1BaseField() Uint256 {
+	return secp256k1BaseField.Clone()
+}
+
+// ReduceMod returns x mod m.
+// It returns an error if m is zero.
+func ReduceMod(x, m Uint256) (Uint256, error) {
+	if m.x.Sign() == 0 {
+		return Uint256{}, errorf("modulus must not be zero")
+	}
+
+	i := Uint256{}
+	i.x.Mod(&x.x, &m.x)
+
+	return i, nil
+}
+
+// IsCanonical reports whether x is the canonical representative of its residue class modulo m, i.e. x < m.
+func IsCanonical(x, m Uint256) bool {
+	return x.x.Cmp(&m.x) < 0
+}
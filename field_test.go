@@ -0,0 +1,86 @@
+package bigutil_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+)
+
+func TestFieldModuli(t *testing.T) {
+	tcs := []struct {
+		name string
+		in   bigutil.Uint256
+		out  string
+	}{
+		{
+			"bn254 scalar field",
+			bigutil.BN254ScalarField(),
+			"21888242871839275222246405745257275088548364400416034343698204186575808495617",
+		},
+		{
+			"bn254 base field",
+			bigutil.BN254BaseField(),
+			"21888242871839275222246405745257275088696311157297823662689037894645226208583",
+		},
+		{
+			"bls12-381 scalar field",
+			bigutil.BLS12381ScalarField(),
+			"52435875175126190479447740508185965837690552500527637822603658699938581184513",
+		},
+		{
+			"secp256k1 order",
+			bigutil.Secp256k1Order(),
+			"115792089237316195423570985008687907852837564279074904382605163141518161494337",
+		},
+		{
+			"secp256k1 base field",
+			bigutil.Secp256k1BaseField(),
+			"115792089237316195423570985008687907853269984665640564039457584007908834671663",
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.out, tc.in.BigInt().String())
+			require.True(t, tc.in.BigInt().ProbablyPrime(20))
+		})
+	}
+
+	t.Run("copy on read", func(t *testing.T) {
+		m := bigutil.Secp256k1Order()
+		m.SetUint64(1)
+
+		require.NotZero(t, bigutil.Secp256k1Order().BigInt().Cmp(big.NewInt(1)))
+	})
+}
+
+func TestReduceMod(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		m := bigutil.Secp256k1Order()
+
+		i, err := bigutil.ReduceMod(m, m)
+		require.Nil(t, err)
+		require.Zero(t, i.BigInt().Sign())
+
+		i, err = bigutil.ReduceMod(bigutil.Uint64ToUint256(10), bigutil.Uint64ToUint256(3))
+		require.Nil(t, err)
+		require.Zero(t, i.BigInt().Cmp(big.NewInt(1)))
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		_, err := bigutil.ReduceMod(bigutil.Uint64ToUint256(10), bigutil.Uint64ToUint256(0))
+		require.Error(t, err)
+	})
+}
+
+func TestIsCanonical(t *testing.T) {
+	m := bigutil.BN254ScalarField()
+	mMinus1 := bigutil.MustBigIntToUint256(new(big.Int).Sub(m.BigInt(), big.NewInt(1)))
+
+	require.True(t, bigutil.IsCanonical(bigutil.Uint64ToUint256(0), m))
+	require.True(t, bigutil.IsCanonical(mMinus1, m))
+	require.False(t, bigutil.IsCanonical(m, m))
+}
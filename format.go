@@ -0,0 +1,41 @@
+package bigutil
+
+// Format is a textual representation of Uint256.
+type Format int
+
+const (
+	// FormatHex is a 0x-prefixed hex string without leading zero digits, as returned by String.
+	FormatHex Format = iota
+	// FormatDecimal is a decimal string without leading zero digits.
+	FormatDecimal
+)
+
+// IsValid reports whether f is one of the defined formats.
+func (f Format) IsValid() bool {
+	return f == FormatHex || f == FormatDecimal
+}
+
+// String implements the fmt.Stringer interface.
+func (f Format) String() string {
+	switch f {
+	case FormatHex:
+		return "hex"
+	case FormatDecimal:
+		return "decimal"
+	default:
+		return "invalid"
+	}
+}
+
+// Text returns the representation of i in the given format.
+// It panics if the format is invalid.
+func (i Uint256) Text(f Format) string {
+	switch f {
+	case FormatHex:
+		return i.string()
+	case FormatDecimal:
+		return i.x.String()
+	default:
+		panic("format must be valid")
+	}
+}
@@ -0,0 +1,94 @@
+package bigutil_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+)
+
+func TestFormat(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name  string
+			in    bigutil.Format
+			out   string
+			valid bool
+		}{
+			{
+				"hex",
+				bigutil.FormatHex,
+				"hex",
+				true,
+			},
+			{
+				"decimal",
+				bigutil.FormatDecimal,
+				"decimal",
+				true,
+			},
+			{
+				"invalid",
+				bigutil.Format(-1),
+				"invalid",
+				false,
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Equal(t, tc.out, tc.in.String())
+				require.Equal(t, tc.valid, tc.in.IsValid())
+			})
+		}
+	})
+}
+
+func TestUint256Text(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name   string
+			in     bigutil.Uint256
+			format bigutil.Format
+			out    string
+		}{
+			{
+				"hex: zero",
+				bigutil.Uint64ToUint256(0),
+				bigutil.FormatHex,
+				"0x0",
+			},
+			{
+				"hex: max",
+				bigutil.MaxUint256(),
+				bigutil.FormatHex,
+				"0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+			},
+			{
+				"decimal: zero",
+				bigutil.Uint64ToUint256(0),
+				bigutil.FormatDecimal,
+				"0",
+			},
+			{
+				"decimal: max",
+				bigutil.MaxUint256(),
+				bigutil.FormatDecimal,
+				"115792089237316195423570985008687907853269984665640564039457584007913129639935",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Equal(t, tc.out, tc.in.Text(tc.format))
+			})
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		require.Panics(t, func() {
+			bigutil.Uint64ToUint256(0).Text(bigutil.Format(-1))
+		})
+	})
+}
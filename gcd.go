@@ -0,0 +1,27 @@
+package bigutil
+
+// GCD returns the greatest common divisor of x and y.
+// GCD(0, 0) is 0.
+func GCD(x, y Uint256) Uint256 {
+	r := Uint256{}
+	r.x.GCD(nil, nil, &x.x, &y.x)
+
+	return r
+}
+
+// LCM returns the least common multiple of x and y.
+// It is 0 if either of them is 0, and returns an error if the result overflows.
+func LCM(x, y Uint256) (Uint256, error) {
+	if x.x.Sign() == 0 || y.x.Sign() == 0 {
+		return Uint256{}, nil
+	}
+
+	r := GCD(x, y)
+	r.x.Quo(&x.x, &r.x)
+	r.x.Mul(&r.x, &y.x)
+	if r.x.BitLen() > maxBitLength {
+		return Uint256{}, errorf("result must be less than or equal to %d bits", maxBitLength)
+	}
+
+	return r, nil
+}
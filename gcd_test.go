@@ -0,0 +1,101 @@
+package bigutil_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+)
+
+func TestGCD(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			x    bigutil.Uint256
+			y    bigutil.Uint256
+			out  bigutil.Uint256
+		}{
+			{
+				"small",
+				bigutil.Uint64ToUint256(12),
+				bigutil.Uint64ToUint256(18),
+				bigutil.Uint64ToUint256(6),
+			},
+			{
+				"coprime",
+				bigutil.Uint64ToUint256(8),
+				bigutil.Uint64ToUint256(9),
+				bigutil.One(),
+			},
+			{
+				"zero",
+				bigutil.Zero(),
+				bigutil.Uint64ToUint256(5),
+				bigutil.Uint64ToUint256(5),
+			},
+			{
+				"both zero",
+				bigutil.Zero(),
+				bigutil.Zero(),
+				bigutil.Zero(),
+			},
+			{
+				"max",
+				bigutil.MaxUint256(),
+				bigutil.MaxUint256(),
+				bigutil.MaxUint256(),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Zero(t, bigutil.GCD(tc.x, tc.y).BigInt().Cmp(tc.out.BigInt()))
+			})
+		}
+	})
+}
+
+func TestLCM(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			x    bigutil.Uint256
+			y    bigutil.Uint256
+			out  bigutil.Uint256
+		}{
+			{
+				"small",
+				bigutil.Uint64ToUint256(4),
+				bigutil.Uint64ToUint256(6),
+				bigutil.Uint64ToUint256(12),
+			},
+			{
+				"zero",
+				bigutil.Zero(),
+				bigutil.Uint64ToUint256(6),
+				bigutil.Zero(),
+			},
+			{
+				"max",
+				bigutil.MaxUint256(),
+				bigutil.Uint64ToUint256(3),
+				bigutil.MaxUint256(),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				out, err := bigutil.LCM(tc.x, tc.y)
+				require.Nil(t, err)
+
+				require.Zero(t, out.BigInt().Cmp(tc.out.BigInt()))
+			})
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		_, err := bigutil.LCM(bigutil.MaxUint256(), bigutil.Uint64ToUint256(2))
+		require.Error(t, err)
+	})
+}
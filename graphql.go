@@ -0,0 +1,48 @@
+package bigutil
+
+import (
+	"encoding/json"
+	"io"
+	"strconv"
+)
+
+// MarshalGQL implements the graphql.Marshaler interface of gqlgen.
+// It writes a quoted hex string, or an unquoted decimal number literal if GQLNumberLiteral is true.
+func (i Uint256) MarshalGQL(w io.Writer) {
+	if GQLNumberLiteral {
+		_, _ = io.WriteString(w, i.x.String())
+		return
+	}
+
+	_, _ = io.WriteString(w, strconv.Quote(i.string()))
+}
+
+// UnmarshalGQL implements the graphql.Unmarshaler interface of gqlgen.
+// It accepts a string in any form accepted by UnmarshalText, or an integer literal unless AcceptedFormats is AcceptHexOnly.
+func (i *Uint256) UnmarshalGQL(v any) error {
+	switch v := v.(type) {
+	case string:
+		return i.UnmarshalText([]byte(v))
+	case json.Number:
+		return i.UnmarshalText([]byte(v))
+	case int:
+		return i.unmarshalGQLInt(int64(v))
+	case int64:
+		return i.unmarshalGQLInt(v)
+	default:
+		return errorf("unexpected value type: %T", v)
+	}
+}
+
+func (i *Uint256) unmarshalGQLInt(v int64) error {
+	if AcceptedFormats == AcceptHexOnly {
+		return newParseError(strconv.FormatInt(v, 10), 0, ReasonMissingPrefix)
+	}
+	if v < 0 {
+		return errorf("must be positive")
+	}
+
+	i.x.SetInt64(v)
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package bigutil_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+)
+
+func TestUint256MarshalGQL(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		var buf bytes.Buffer
+		bigutil.MaxUint256().MarshalGQL(&buf)
+
+		require.Equal(t, `"0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"`, buf.String())
+	})
+
+	t.Run("number literal", func(t *testing.T) {
+		bigutil.GQLNumberLiteral = true
+		t.Cleanup(func() {
+			bigutil.GQLNumberLiteral = false
+		})
+
+		var buf bytes.Buffer
+		bigutil.MaxUint256().MarshalGQL(&buf)
+
+		require.Equal(t, "115792089237316195423570985008687907853269984665640564039457584007913129639935", buf.String())
+	})
+}
+
+func TestUint256UnmarshalGQL(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   any
+			out  bigutil.Uint256
+		}{
+			{
+				"hex string",
+				"0xff",
+				bigutil.Uint64ToUint256(255),
+			},
+			{
+				"decimal string",
+				"255",
+				bigutil.Uint64ToUint256(255),
+			},
+			{
+				"json number",
+				json.Number("115792089237316195423570985008687907853269984665640564039457584007913129639935"),
+				bigutil.MaxUint256(),
+			},
+			{
+				"int",
+				255,
+				bigutil.Uint64ToUint256(255),
+			},
+			{
+				"int64",
+				int64(255),
+				bigutil.Uint64ToUint256(255),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var i bigutil.Uint256
+				require.Nil(t, i.UnmarshalGQL(tc.in))
+
+				require.Zero(t, i.BigInt().Cmp(tc.out.BigInt()))
+			})
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   any
+		}{
+			{
+				"negative int",
+				-1,
+			},
+			{
+				"invalid string",
+				"0xg",
+			},
+			{
+				"float",
+				1.5,
+			},
+			{
+				"nil",
+				nil,
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var i bigutil.Uint256
+				require.Error(t, i.UnmarshalGQL(tc.in))
+			})
+		}
+	})
+}
@@ -0,0 +1,87 @@
+package bigutil
+
+// Uint256Heap is a min-heap of Uint256 values.
+// It implements the heap.Interface and is meant to be used with the container/heap package.
+type Uint256Heap []Uint256
+
+// Len implements the sort.Interface.
+func (h Uint256Heap) Len() int {
+	return len(h)
+}
+
+// Less implements the sort.Interface.
+func (h Uint256Heap) Less(i, j int) bool {
+	return h[i].x.Cmp(&h[j].x) < 0
+}
+
+// Swap implements the sort.Interface.
+func (h Uint256Heap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+}
+
+// Push implements the heap.Interface.
+func (h *Uint256Heap) Push(x any) {
+	*h = append(*h, x.(Uint256))
+}
+
+// Pop implements the heap.Interface.
+func (h *Uint256Heap) Pop() any {
+	old := *h
+	n := len(old)
+
+	x := old[n-1]
+	old[n-1] = Uint256{}
+	*h = old[:n-1]
+
+	return x
+}
+
+// PriorityItem is a value with a Uint256 priority.
+type PriorityItem[T any] struct {
+	Priority Uint256
+	Value    T
+}
+
+// PriorityHeap is a heap of PriorityItems ordered by priority.
+// It implements the heap.Interface and is meant to be used with the container/heap package.
+type PriorityHeap[T any] struct {
+	Items []PriorityItem[T]
+	// Max makes the item with the highest priority come first instead of the lowest.
+	Max bool
+}
+
+// Len implements the sort.Interface.
+func (h *PriorityHeap[T]) Len() int {
+	return len(h.Items)
+}
+
+// Less implements the sort.Interface.
+func (h *PriorityHeap[T]) Less(i, j int) bool {
+	c := h.Items[i].Priority.x.Cmp(&h.Items[j].Priority.x)
+	if h.Max {
+		return c > 0
+	}
+
+	return c < 0
+}
+
+// Swap implements the sort.Interface.
+func (h *PriorityHeap[T]) Swap(i, j int) {
+	h.Items[i], h.Items[j] = h.Items[j], h.Items[i]
+}
+
+// Push implements the heap.Interface.
+func (h *PriorityHeap[T]) Push(x any) {
+	h.Items = append(h.Items, x.(PriorityItem[T]))
+}
+
+// Pop implements the heap.Interface.
+func (h *PriorityHeap[T]) Pop() any {
+	n := len(h.Items)
+
+	x := h.Items[n-1]
+	h.Items[n-1] = PriorityItem[T]{}
+	h.Items = h.Items[:n-1]
+
+	return x
+}
@@ -0,0 +1,59 @@
+package bigutil_test
+
+import (
+	"container/heap"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+)
+
+func TestUint256Heap(t *testing.T) {
+	h := bigutil.Uint256Heap(uint256s(5, 256, 1))
+	heap.Init(&h)
+	heap.Push(&h, bigutil.Uint64ToUint256(3))
+	heap.Push(&h, bigutil.Uint64ToUint256(0))
+
+	var is []bigutil.Uint256
+	for h.Len() > 0 {
+		is = append(is, heap.Pop(&h).(bigutil.Uint256))
+	}
+
+	requireUint256sEqual(t, uint256s(0, 1, 3, 5, 256), is)
+}
+
+func TestPriorityHeap(t *testing.T) {
+	tcs := []struct {
+		name string
+		max  bool
+		out  []string
+	}{
+		{
+			"min",
+			false,
+			[]string{"c", "a", "b"},
+		},
+		{
+			"max",
+			true,
+			[]string{"b", "a", "c"},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			h := &bigutil.PriorityHeap[string]{Max: tc.max}
+			heap.Push(h, bigutil.PriorityItem[string]{Priority: bigutil.Uint64ToUint256(2), Value: "a"})
+			heap.Push(h, bigutil.PriorityItem[string]{Priority: bigutil.Uint64ToUint256(256), Value: "b"})
+			heap.Push(h, bigutil.PriorityItem[string]{Priority: bigutil.Uint64ToUint256(1), Value: "c"})
+
+			var out []string
+			for h.Len() > 0 {
+				out = append(out, heap.Pop(h).(bigutil.PriorityItem[string]).Value)
+			}
+
+			require.Equal(t, tc.out, out)
+		})
+	}
+}
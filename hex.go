@@ -0,0 +1,102 @@
+package bigutil
+
+import (
+	"math/big"
+)
+
+const hexDigits = "0123456789abcdef"
+
+const invalidNibble = 0xff
+
+// hexNibbles maps an ASCII character to the value of the hex digit it represents,
+// or to invalidNibble if it is not a hex digit.
+var hexNibbles = func() [256]byte {
+	var t [256]byte
+	for c := range t {
+		t[c] = invalidNibble
+	}
+	for c := '0'; c <= '9'; c++ {
+		t[c] = byte(c - '0')
+	}
+	for c := 'a'; c <= 'f'; c++ {
+		t[c] = byte(c-'a') + 10
+	}
+	for c := 'A'; c <= 'F'; c++ {
+		t[c] = byte(c-'A') + 10
+	}
+
+	return t
+}()
+
+// encodeHex encodes the given big.Int, which must be in the uint256 range,
+// as a 0x-prefixed hex string without leading zero digits.
+func encodeHex(x *big.Int) string {
+	var b [maxByteLength]byte
+	x.FillBytes(b[:])
+
+	var buf [2 + maxByteLength*2]byte
+	buf[0], buf[1] = '0', 'x'
+	for idx, v := range b {
+		buf[2+idx*2] = hexDigits[v>>4]
+		buf[2+idx*2+1] = hexDigits[v&0x0f]
+	}
+
+	digits := buf[2:]
+	n := 0
+	for n < len(digits)-1 && digits[n] == '0' {
+		n++
+	}
+	copy(buf[2:], digits[n:])
+
+	return string(buf[:len(buf)-n])
+}
+
+// decodeHex decodes the given 0x-prefixed hex string into a big.Int in the uint256 range.
+// Leading zero digits are rejected unless allowLeadingZeros is true.
+func decodeHex[T ~string | ~[]byte](s T, allowLeadingZeros bool) (*big.Int, error) {
+	b, err := decodeHexBytes(s, allowLeadingZeros)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(big.Int).SetBytes(b[:]), nil
+}
+
+// decodeHexBytes is like decodeHex but returns the big-endian 32-byte representation.
+func decodeHexBytes[T ~string | ~[]byte](s T, allowLeadingZeros bool) ([maxByteLength]byte, error) {
+	var b [maxByteLength]byte
+
+	if len(s) < 2 || s[0] != '0' || (s[1] != 'x' && s[1] != 'X') {
+		return b, newParseError(s, 0, ReasonMissingPrefix)
+	}
+	if len(s) == 2 {
+		return b, newParseError(s, 2, ReasonEmpty)
+	}
+
+	start := 2
+	if !allowLeadingZeros && len(s) > 3 && s[2] == '0' {
+		return b, newParseError(s, 2, ReasonLeadingZero)
+	}
+	for start < len(s)-1 && s[start] == '0' {
+		start++
+	}
+	for idx := start; idx < len(s); idx++ {
+		if hexNibbles[s[idx]] == invalidNibble {
+			return b, newParseError(s, idx, ReasonInvalidDigit)
+		}
+	}
+	if len(s)-start > maxByteLength*2 {
+		return b, newParseError(s, -1, ReasonOutOfRange)
+	}
+
+	for idx, pos := len(s)-1, len(b)*2-1; idx >= start; idx, pos = idx-1, pos-1 {
+		v := hexNibbles[s[idx]]
+		if pos%2 == 0 {
+			b[pos/2] |= v << 4
+		} else {
+			b[pos/2] |= v
+		}
+	}
+
+	return b, nil
+}
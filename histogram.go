@@ -0,0 +1,106 @@
+package bigutil
+
+import (
+	"math/big"
+)
+
+// Histogram counts Uint256 observations in buckets.
+// Bucket k counts the observations less than or equal to the k-th bound and greater than the previous one,
+// and the last bucket counts the observations greater than all bounds.
+// It is not safe for concurrent use.
+type Histogram struct {
+	bounds []Uint256
+	counts []uint64
+}
+
+// NewHistogram returns a new Histogram with the given upper bounds, which must be strictly increasing.
+func NewHistogram(bounds []Uint256) (*Histogram, error) {
+	for idx := 1; idx < len(bounds); idx++ {
+		if Compare(bounds[idx-1], bounds[idx]) >= 0 {
+			return nil, errorf("bounds must be strictly increasing")
+		}
+	}
+
+	h := &Histogram{
+		bounds: make([]Uint256, len(bounds)),
+		counts: make([]uint64, len(bounds)+1),
+	}
+	for idx, b := range bounds {
+		h.bounds[idx] = b.Clone()
+	}
+
+	return h, nil
+}
+
+// LinearBounds returns count bounds starting at start and spaced by width.
+func LinearBounds(start, width Uint256, count int) ([]Uint256, error) {
+	if width.x.Sign() == 0 {
+		return nil, errorf("width must not be zero")
+	}
+
+	return bounds(start, count, func(x *big.Int) {
+		x.Add(x, &width.x)
+	})
+}
+
+// ExponentialBounds returns count bounds starting at start and multiplied by factor.
+func ExponentialBounds(start Uint256, factor uint64, count int) ([]Uint256, error) {
+	if start.x.Sign() == 0 {
+		return nil, errorf("start must not be zero")
+	}
+	if factor < 2 {
+		return nil, errorf("factor must be greater than or equal to 2")
+	}
+
+	f := new(big.Int).SetUint64(factor)
+
+	return bounds(start, count, func(x *big.Int) {
+		x.Mul(x, f)
+	})
+}
+
+// Observe records the given value.
+func (h *Histogram) Observe(i Uint256) {
+	idx, _ := SearchSlice(h.bounds, i)
+	h.counts[idx]++
+}
+
+// Bounds returns the upper bounds of the buckets.
+func (h *Histogram) Bounds() []Uint256 {
+	bounds := make([]Uint256, len(h.bounds))
+	for idx, b := range h.bounds {
+		bounds[idx] = b.Clone()
+	}
+
+	return bounds
+}
+
+// Counts returns the number of observations in each bucket.
+// It has one more element than Bounds for the observations greater than all bounds.
+func (h *Histogram) Counts() []uint64 {
+	counts := make([]uint64, len(h.counts))
+	copy(counts, h.counts)
+
+	return counts
+}
+
+func bounds(start Uint256, count int, next func(x *big.Int)) ([]Uint256, error) {
+	if count < 1 {
+		return nil, errorf("count must be positive")
+	}
+
+	bs := make([]Uint256, count)
+	x := new(big.Int).Set(&start.x)
+	for idx := range bs {
+		if idx > 0 {
+			next(x)
+		}
+		if x.BitLen() > maxBitLength {
+			return nil, errorf("bounds must be less than or equal to %d bits", maxBitLength)
+		}
+
+		bs[idx].x.Set(x)
+	}
+
+	return bs, nil
+}
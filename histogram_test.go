@@ -0,0 +1,69 @@
+package bigutil_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+)
+
+func TestNewHistogram(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		h, err := bigutil.NewHistogram(uint256s(10, 100))
+		require.Nil(t, err)
+
+		for _, i := range uint256s(0, 10, 11, 100, 101) {
+			h.Observe(i)
+		}
+		h.Observe(bigutil.MaxUint256())
+
+		requireUint256sEqual(t, uint256s(10, 100), h.Bounds())
+		require.Equal(t, []uint64{2, 2, 2}, h.Counts())
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		_, err := bigutil.NewHistogram(uint256s(10, 10))
+		require.Error(t, err)
+	})
+}
+
+func TestLinearBounds(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		bs, err := bigutil.LinearBounds(bigutil.Uint64ToUint256(5), bigutil.Uint64ToUint256(10), 3)
+		require.Nil(t, err)
+
+		requireUint256sEqual(t, uint256s(5, 15, 25), bs)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		_, err := bigutil.LinearBounds(bigutil.Uint64ToUint256(5), bigutil.Uint64ToUint256(0), 3)
+		require.Error(t, err)
+
+		_, err = bigutil.LinearBounds(bigutil.MaxUint256(), bigutil.Uint64ToUint256(1), 2)
+		require.Error(t, err)
+
+		_, err = bigutil.LinearBounds(bigutil.Uint64ToUint256(5), bigutil.Uint64ToUint256(1), 0)
+		require.Error(t, err)
+	})
+}
+
+func TestExponentialBounds(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		bs, err := bigutil.ExponentialBounds(bigutil.Uint64ToUint256(1), 10, 4)
+		require.Nil(t, err)
+
+		requireUint256sEqual(t, uint256s(1, 10, 100, 1000), bs)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		_, err := bigutil.ExponentialBounds(bigutil.Uint64ToUint256(0), 10, 4)
+		require.Error(t, err)
+
+		_, err = bigutil.ExponentialBounds(bigutil.Uint64ToUint256(1), 1, 4)
+		require.Error(t, err)
+
+		_, err = bigutil.ExponentialBounds(bigutil.Uint64ToUint256(1), 2, 258)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,129 @@
+package bigutil
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+	"sync"
+	"time"
+)
+
+// IDEntropyLength is the length of the entropy part of an ID in bytes.
+const IDEntropyLength = 20
+
+// IDComponents are the components of an ID.
+//
+// An ID is laid out, from the most significant bit, as
+// the 64-bit Unix time in milliseconds, the 32-bit shard and the 160-bit entropy,
+// so that IDs sort by time first.
+type IDComponents struct {
+	Time    time.Time
+	Shard   uint32
+	Entropy [IDEntropyLength]byte
+}
+
+// NewID composes an ID from the given components.
+// It returns an error if the time is before the Unix epoch.
+func NewID(c IDComponents) (Uint256, error) {
+	ms := c.Time.UnixMilli()
+	if ms < 0 {
+		return Uint256{}, errorf("time must not be before the Unix epoch")
+	}
+
+	var b [maxByteLength]byte
+	binary.BigEndian.PutUint64(b[0:8], uint64(ms))
+	binary.BigEndian.PutUint32(b[8:12], c.Shard)
+	copy(b[12:], c.Entropy[:])
+
+	i := Uint256{}
+	i.x.SetBytes(b[:])
+
+	return i, nil
+}
+
+// ParseID decomposes the given ID into its components.
+func ParseID(id Uint256) IDComponents {
+	b := id.bytes32()
+
+	c := IDComponents{
+		Time:  time.UnixMilli(int64(binary.BigEndian.Uint64(b[0:8]))),
+		Shard: binary.BigEndian.Uint32(b[8:12]),
+	}
+	copy(c.Entropy[:], b[12:])
+
+	return c
+}
+
+// IDGenerator generates sortable IDs for a shard.
+// IDs generated within the same millisecond are made monotonic by incrementing the entropy of the previous one.
+// The zero value is ready to use, and it is safe for concurrent use.
+type IDGenerator struct {
+	// Shard is the shard embedded in the generated IDs.
+	Shard uint32
+	// Entropy is the source of the entropy. If nil, crypto/rand.Reader is used.
+	Entropy io.Reader
+	// Now returns the current time. If nil, time.Now is used.
+	Now func() time.Time
+
+	mu          sync.Mutex
+	started     bool
+	lastMs      int64
+	lastEntropy [IDEntropyLength]byte
+}
+
+// Next returns a new ID.
+func (g *IDGenerator) Next() (Uint256, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	now := g.Now
+	if now == nil {
+		now = time.Now
+	}
+	entropy := g.Entropy
+	if entropy == nil {
+		entropy = rand.Reader
+	}
+
+	t := now()
+	ms := t.UnixMilli()
+
+	// The state is updated only on success, so that a failed call does not affect the following ones.
+	next := g.lastEntropy
+	if g.started && ms == g.lastMs {
+		if !incrementBytes(next[:]) {
+			return Uint256{}, errorf("entropy must not overflow within a millisecond")
+		}
+	} else {
+		if _, err := io.ReadFull(entropy, next[:]); err != nil {
+			return Uint256{}, err
+		}
+	}
+
+	id, err := NewID(IDComponents{
+		Time:    t,
+		Shard:   g.Shard,
+		Entropy: next,
+	})
+	if err != nil {
+		return Uint256{}, err
+	}
+
+	g.started = true
+	g.lastMs = ms
+	g.lastEntropy = next
+
+	return id, nil
+}
+
+// incrementBytes increments the given big-endian bytes by one, and reports false on overflow.
+func incrementBytes(b []byte) bool {
+	for idx := len(b) - 1; idx >= 0; idx-- {
+		b[idx]++
+		if b[idx] != 0 {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,110 @@
+package bigutil_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+)
+
+func TestNewID(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := bigutil.IDComponents{
+			Time:  time.UnixMilli(1700000000000),
+			Shard: 0x01020304,
+		}
+		for idx := range c.Entropy {
+			c.Entropy[idx] = byte(idx)
+		}
+
+		id, err := bigutil.NewID(c)
+		require.Nil(t, err)
+		require.Equal(t, "0x18bcfe5680001020304000102030405060708090a0b0c0d0e0f10111213", id.String())
+
+		parsed := bigutil.ParseID(id)
+		require.True(t, c.Time.Equal(parsed.Time))
+		require.Equal(t, c.Shard, parsed.Shard)
+		require.Equal(t, c.Entropy, parsed.Entropy)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		_, err := bigutil.NewID(bigutil.IDComponents{
+			Time: time.UnixMilli(-1),
+		})
+		require.Error(t, err)
+	})
+}
+
+func TestIDGeneratorNext(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		now := time.UnixMilli(1700000000000)
+
+		g := &bigutil.IDGenerator{
+			Shard:   7,
+			Entropy: bytes.NewReader(bytes.Repeat([]byte{0xff}, 2*bigutil.IDEntropyLength)),
+			Now:     func() time.Time { return now },
+		}
+
+		first, err := g.Next()
+		require.Nil(t, err)
+
+		// The entropy overflows within the same millisecond.
+		_, err = g.Next()
+		require.Error(t, err)
+
+		now = now.Add(time.Millisecond)
+
+		second, err := g.Next()
+		require.Nil(t, err)
+		require.Equal(t, -1, bigutil.Compare(first, second))
+
+		c := bigutil.ParseID(second)
+		require.True(t, now.Equal(c.Time))
+		require.Equal(t, uint32(7), c.Shard)
+	})
+
+	t.Run("monotonic", func(t *testing.T) {
+		g := &bigutil.IDGenerator{
+			Now: func() time.Time { return time.UnixMilli(1700000000000) },
+		}
+
+		prev, err := g.Next()
+		require.Nil(t, err)
+
+		for range 100 {
+			id, err := g.Next()
+			require.Nil(t, err)
+
+			require.Equal(t, -1, bigutil.Compare(prev, id))
+			prev = id
+		}
+	})
+
+	t.Run("overflow", func(t *testing.T) {
+		g := &bigutil.IDGenerator{
+			Entropy: bytes.NewReader(bytes.Repeat([]byte{0xff}, bigutil.IDEntropyLength)),
+			Now:     func() time.Time { return time.UnixMilli(1700000000000) },
+		}
+
+		_, err := g.Next()
+		require.Nil(t, err)
+
+		// A failed call must not wrap the entropy around, which would break the monotonicity.
+		for range 2 {
+			_, err = g.Next()
+			require.Error(t, err)
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		g := &bigutil.IDGenerator{
+			Entropy: bytes.NewReader(nil),
+		}
+
+		_, err := g.Next()
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,30 @@
+package bigutil
+
+import (
+	"iter"
+	"math/big"
+)
+
+// Range returns an iterator over the values from from (inclusive) to to (exclusive), incremented by step.
+// The iteration stops before any value exceeding the uint256 range.
+// It panics if step is zero.
+func Range(from, to, step Uint256) iter.Seq[Uint256] {
+	if step.x.Sign() == 0 {
+		panic("step must not be zero")
+	}
+
+	return func(yield func(Uint256) bool) {
+		x := new(big.Int).Set(&from.x)
+
+		for x.Cmp(&to.x) < 0 {
+			i := Uint256{}
+			i.x.Set(x)
+
+			if !yield(i) {
+				return
+			}
+
+			x.Add(x, &step.x)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package bigutil_test
+
+import (
+	"math/big"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+)
+
+func TestRange(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			from bigutil.Uint256
+			to   bigutil.Uint256
+			step bigutil.Uint256
+			out  []bigutil.Uint256
+		}{
+			{
+				"empty",
+				bigutil.Uint64ToUint256(1),
+				bigutil.Uint64ToUint256(1),
+				bigutil.Uint64ToUint256(1),
+				nil,
+			},
+			{
+				"step 1",
+				bigutil.Uint64ToUint256(0),
+				bigutil.Uint64ToUint256(3),
+				bigutil.Uint64ToUint256(1),
+				uint256s(0, 1, 2),
+			},
+			{
+				"step 3",
+				bigutil.Uint64ToUint256(1),
+				bigutil.Uint64ToUint256(10),
+				bigutil.Uint64ToUint256(3),
+				uint256s(1, 4, 7),
+			},
+			{
+				"near max",
+				bigutil.MustBigIntToUint256(new(big.Int).Sub(bigutil.MaxUint256().BigInt(), big.NewInt(2))),
+				bigutil.MaxUint256(),
+				bigutil.MaxUint256(),
+				[]bigutil.Uint256{
+					bigutil.MustBigIntToUint256(new(big.Int).Sub(bigutil.MaxUint256().BigInt(), big.NewInt(2))),
+				},
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				requireUint256sEqual(t, tc.out, slices.Collect(bigutil.Range(tc.from, tc.to, tc.step)))
+			})
+		}
+	})
+
+	t.Run("break", func(t *testing.T) {
+		var is []bigutil.Uint256
+		for i := range bigutil.Range(bigutil.Uint64ToUint256(0), bigutil.Uint64ToUint256(100), bigutil.Uint64ToUint256(1)) {
+			if len(is) == 2 {
+				break
+			}
+			is = append(is, i)
+		}
+
+		requireUint256sEqual(t, uint256s(0, 1), is)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		require.Panics(t, func() {
+			bigutil.Range(bigutil.Uint64ToUint256(0), bigutil.Uint64ToUint256(1), bigutil.Uint64ToUint256(0))
+		})
+	})
+}
@@ -0,0 +1,152 @@
+package bigutil
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+// maxSafeInteger is 2^53, the largest magnitude up to which float64 holds every integer.
+var maxSafeInteger = new(big.Int).Lsh(big.NewInt(1), 53)
+
+// MarshalCanonicalJSON returns the JSON encoding of v canonicalized as specified by RFC 8785 (JCS),
+// suitable for signing and content addressing.
+// Object members are sorted by the UTF-16 code units of their names, strings use the minimal escaping,
+// and numbers use the ECMAScript serialization, so integers above 2^53 in magnitude must not be encoded as JSON numbers;
+// it returns an error for such an integer rather than rounding it.
+// Uint256 values are encoded as their canonical form: a lowercase 0x-prefixed hex string without leading zero digits.
+func MarshalCanonicalJSON(v any) ([]byte, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+
+	var tree any
+	if err := dec.Decode(&tree); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := writeCanonicalJSON(&buf, tree); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func writeCanonicalJSON(buf *bytes.Buffer, v any) error {
+	switch v := v.(type) {
+	case nil:
+		buf.WriteString("null")
+	case bool:
+		buf.WriteString(strconv.FormatBool(v))
+	case json.Number:
+		b, err := canonicalJSONNumber(v)
+		if err != nil {
+			return err
+		}
+		buf.Write(b)
+	case string:
+		writeCanonicalJSONString(buf, v)
+	case []any:
+		buf.WriteByte('[')
+		for idx, elem := range v {
+			if idx > 0 {
+				buf.WriteByte(',')
+			}
+			if err := writeCanonicalJSON(buf, elem); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		slices.SortFunc(keys, func(a, b string) int {
+			return slices.Compare(utf16.Encode([]rune(a)), utf16.Encode([]rune(b)))
+		})
+
+		buf.WriteByte('{')
+		for idx, k := range keys {
+			if idx > 0 {
+				buf.WriteByte(',')
+			}
+			writeCanonicalJSONString(buf, k)
+			buf.WriteByte(':')
+			if err := writeCanonicalJSON(buf, v[k]); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte('}')
+	default:
+		return errorf("unexpected value type: %T", v)
+	}
+
+	return nil
+}
+
+// canonicalJSONNumber returns the ECMAScript serialization of n as float64.
+// Fractional and exponent forms are rounded to the nearest float64 as RFC 8785 requires,
+// but it returns an error for integers above 2^53 in magnitude,
+// since float64 cannot hold them all and rounding would silently change the data.
+func canonicalJSONNumber(n json.Number) ([]byte, error) {
+	f, err := n.Float64()
+	if err != nil {
+		return nil, err
+	}
+
+	if s := n.String(); !strings.ContainsAny(s, ".eE") {
+		x, ok := new(big.Int).SetString(s, 10)
+		if !ok || x.CmpAbs(maxSafeInteger) > 0 {
+			return nil, errorf("integer must be at most 2^53 in magnitude: %s", n)
+		}
+	}
+
+	if f == 0 {
+		// -0 is serialized as 0.
+		f = 0
+	}
+
+	// encoding/json serializes float64 as ECMAScript does.
+	return json.Marshal(f)
+}
+
+func writeCanonicalJSONString(buf *bytes.Buffer, s string) {
+	buf.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			buf.WriteString(`\"`)
+		case '\\':
+			buf.WriteString(`\\`)
+		case '\b':
+			buf.WriteString(`\b`)
+		case '\f':
+			buf.WriteString(`\f`)
+		case '\n':
+			buf.WriteString(`\n`)
+		case '\r':
+			buf.WriteString(`\r`)
+		case '\t':
+			buf.WriteString(`\t`)
+		default:
+			if r < 0x20 {
+				buf.WriteString(`\u00`)
+				buf.WriteByte(hexDigits[r>>4])
+				buf.WriteByte(hexDigits[r&0xf])
+				continue
+			}
+			buf.WriteRune(r)
+		}
+	}
+	buf.WriteByte('"')
+}
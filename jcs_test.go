@@ -0,0 +1,100 @@
+package bigutil_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+)
+
+func TestMarshalCanonicalJSON(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		type payment struct {
+			To     string            `json:"to"`
+			Amount bigutil.Uint256   `json:"amount"`
+			Fees   []bigutil.Uint256 `json:"fees"`
+			Memo   *string           `json:"memo"`
+		}
+
+		tcs := []struct {
+			name string
+			in   any
+			out  string
+		}{
+			{
+				"struct with Uint256",
+				payment{
+					To:     "alice",
+					Amount: bigutil.MaxUint256(),
+					Fees:   uint256s(0, 255),
+				},
+				`{"amount":"0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff","fees":["0x0","0xff"],"memo":null,"to":"alice"}`,
+			},
+			{
+				// RFC 8785, section 3.2.3.
+				"key order",
+				json.RawMessage(`{"\u20ac":"Euro Sign","\r":"Carriage Return","\ufb33":"Hebrew Letter Dalet With Dagesh","1":"One","\ud83d\ude00":"Emoji: Grinning Face","\u0080":"Control","\u00f6":"Latin Small Letter O With Diaeresis"}`),
+				"{\"\\r\":\"Carriage Return\",\"1\":\"One\",\"\u0080\":\"Control\",\"\u00f6\":\"Latin Small Letter O With Diaeresis\",\"\u20ac\":\"Euro Sign\",\"\U0001f600\":\"Emoji: Grinning Face\",\"\ufb33\":\"Hebrew Letter Dalet With Dagesh\"}",
+			},
+			{
+				// RFC 8785, section 3.2.2.
+				"numbers",
+				json.RawMessage(`[333333333.33333329, 1E30, 4.50, 2e-3, 0.000000000000000000000000001, -0, 1e21, 1e-7, 0.000001, 9007199254740992]`),
+				`[333333333.3333333,1e+30,4.5,0.002,1e-27,0,1e+21,1e-7,0.000001,9007199254740992]`,
+			},
+			{
+				// RFC 8785, section 3.2.2.
+				"strings",
+				json.RawMessage(`"\u20ac$\u000F\u000aA'\u0042\u0022\u005c\\\"\/<>&\u2028"`),
+				"\"\u20ac$\\u000f\\nA'B\\\"\\\\\\\\\\\"/<>&\u2028\"",
+			},
+			{
+				"literals",
+				[]any{true, false, nil},
+				`[true,false,null]`,
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				b, err := bigutil.MarshalCanonicalJSON(tc.in)
+				require.Nil(t, err)
+
+				require.Equal(t, tc.out, string(b))
+			})
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   any
+		}{
+			{
+				"unsupported type",
+				make(chan int),
+			},
+			{
+				"uint64 above 2^53",
+				struct{ N uint64 }{1<<63 + 1},
+			},
+			{
+				"integer above 2^53",
+				json.RawMessage(`-9007199254740993`),
+			},
+			{
+				"overflow",
+				json.RawMessage(`1e400`),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := bigutil.MarshalCanonicalJSON(tc.in)
+				require.Error(t, err)
+			})
+		}
+	})
+}
@@ -0,0 +1,143 @@
+package bigutil
+
+// HashFunc returns the digest of the given data as Uint256, e.g. Keccak256ToUint256 or SHA256ToUint256.
+type HashFunc func(data ...[]byte) Uint256
+
+// Merkle builds and verifies Merkle trees over Uint256 leaves.
+// Leaves and internal nodes are hashed with distinct one-byte prefixes, 0x00 and 0x01 respectively,
+// so that an internal node cannot be passed off as a leaf (the second preimage attack).
+// Pairs are hashed in sorted order,
+// so proofs do not need to record whether each sibling is on the left or the right.
+// A node without a sibling is promoted to the next level unchanged.
+// The zero value uses Keccak256ToUint256.
+type Merkle struct {
+	// Hash is the hash function. If nil, Keccak256ToUint256 is used.
+	Hash HashFunc
+}
+
+var (
+	merkleLeafPrefix = []byte{0x00}
+	merkleNodePrefix = []byte{0x01}
+)
+
+// HashLeaf returns the hash of the 0x00 prefix followed by the 32-byte representation of leaf.
+func (m Merkle) HashLeaf(leaf Uint256) Uint256 {
+	b := leaf.bytes32()
+
+	return m.hash()(merkleLeafPrefix, b[:])
+}
+
+// HashPair returns the hash of the 0x01 prefix followed by the concatenated 32-byte representations of a and b,
+// the smaller one first.
+func (m Merkle) HashPair(a, b Uint256) Uint256 {
+	if a.x.Cmp(&b.x) > 0 {
+		a, b = b, a
+	}
+
+	ab, bb := a.bytes32(), b.bytes32()
+
+	return m.hash()(merkleNodePrefix, ab[:], bb[:])
+}
+
+// Root returns the root of the tree over the given leaves.
+// It returns an error if there are no leaves.
+func (m Merkle) Root(leaves []Uint256) (Uint256, error) {
+	if len(leaves) == 0 {
+		return Uint256{}, errorf("leaves must not be empty")
+	}
+
+	level := m.hashLeaves(leaves)
+	for len(level) > 1 {
+		level = m.nextLevel(level)
+	}
+
+	return level[0], nil
+}
+
+// Proof returns the sibling hashes from the leaf at the given index up to the root.
+// It returns an error if index is out of range.
+func (m Merkle) Proof(leaves []Uint256, index int) ([]Uint256, error) {
+	if index < 0 || index >= len(leaves) {
+		return nil, errorf("index must be in [0, %d)", len(leaves))
+	}
+
+	var proof []Uint256
+
+	level := m.hashLeaves(leaves)
+	for len(level) > 1 {
+		if sibling := index ^ 1; sibling < len(level) {
+			proof = append(proof, level[sibling])
+		}
+
+		level = m.nextLevel(level)
+		index /= 2
+	}
+
+	return proof, nil
+}
+
+// Verify reports whether the given proof proves that leaf is in the tree with the given root.
+func (m Merkle) Verify(proof []Uint256, root, leaf Uint256) bool {
+	computed := m.HashLeaf(leaf)
+	for _, p := range proof {
+		computed = m.HashPair(computed, p)
+	}
+
+	return computed.x.Cmp(&root.x) == 0
+}
+
+func (m Merkle) hash() HashFunc {
+	if m.Hash == nil {
+		return Keccak256ToUint256
+	}
+
+	return m.Hash
+}
+
+func (m Merkle) hashLeaves(leaves []Uint256) []Uint256 {
+	hashes := make([]Uint256, len(leaves))
+	for idx, leaf := range leaves {
+		hashes[idx] = m.HashLeaf(leaf)
+	}
+
+	return hashes
+}
+
+func (m Merkle) nextLevel(level []Uint256) []Uint256 {
+	next := make([]Uint256, 0, (len(level)+1)/2)
+	for idx := 0; idx < len(level); idx += 2 {
+		if idx+1 == len(level) {
+			next = append(next, level[idx])
+			break
+		}
+
+		next = append(next, m.HashPair(level[idx], level[idx+1]))
+	}
+
+	return next
+}
+
+// HashLeaf is Merkle.HashLeaf with Keccak256ToUint256.
+func HashLeaf(leaf Uint256) Uint256 {
+	return Merkle{}.HashLeaf(leaf)
+}
+
+// HashPair is Merkle.HashPair with Keccak256ToUint256.
+func HashPair(a, b Uint256) Uint256 {
+	return Merkle{}.HashPair(a, b)
+}
+
+// MerkleRoot is Merkle.Root with Keccak256ToUint256.
+func MerkleRoot(leaves []Uint256) (Uint256, error) {
+	return Merkle{}.Root(leaves)
+}
+
+// MerkleProof is Merkle.Proof with Keccak256ToUint256.
+func MerkleProof(leaves []Uint256, index int) ([]Uint256, error) {
+	return Merkle{}.Proof(leaves, index)
+}
+
+// VerifyMerkleProof is Merkle.Verify with Keccak256ToUint256.
+func VerifyMerkleProof(proof []Uint256, root, leaf Uint256) bool {
+	return Merkle{}.Verify(proof, root, leaf)
+}
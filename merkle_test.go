@@ -0,0 +1,106 @@
+package bigutil_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+)
+
+func TestHashLeaf(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		a := bigutil.One()
+
+		ab := a.Bytes32()
+		expected := bigutil.Keccak256ToUint256([]byte{0x00}, ab[:])
+
+		require.Equal(t, expected.String(), bigutil.HashLeaf(a).String())
+
+		sha := bigutil.Merkle{Hash: bigutil.SHA256ToUint256}
+		require.Equal(t, bigutil.SHA256ToUint256([]byte{0x00}, ab[:]).String(), sha.HashLeaf(a).String())
+	})
+}
+
+func TestHashPair(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		a := bigutil.One()
+		b := bigutil.Uint64ToUint256(2)
+
+		ab, bb := a.Bytes32(), b.Bytes32()
+		expected := bigutil.Keccak256ToUint256([]byte{0x01}, ab[:], bb[:])
+
+		require.Equal(t, expected.String(), bigutil.HashPair(a, b).String())
+		require.Equal(t, expected.String(), bigutil.HashPair(b, a).String())
+
+		sha := bigutil.Merkle{Hash: bigutil.SHA256ToUint256}
+		require.Equal(t, bigutil.SHA256ToUint256([]byte{0x01}, ab[:], bb[:]).String(), sha.HashPair(b, a).String())
+	})
+}
+
+func TestMerkleRoot(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		leaves := uint256s(1, 2, 3)
+
+		root, err := bigutil.MerkleRoot(leaves)
+		require.Nil(t, err)
+
+		// The third leaf has no sibling and is promoted.
+		expected := bigutil.HashPair(
+			bigutil.HashPair(bigutil.HashLeaf(leaves[0]), bigutil.HashLeaf(leaves[1])),
+			bigutil.HashLeaf(leaves[2]),
+		)
+		require.Equal(t, expected.String(), root.String())
+
+		root, err = bigutil.MerkleRoot(leaves[:1])
+		require.Nil(t, err)
+		require.Equal(t, bigutil.HashLeaf(leaves[0]).String(), root.String())
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		_, err := bigutil.MerkleRoot(nil)
+		require.Error(t, err)
+	})
+}
+
+func TestMerkleProof(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		for _, n := range []int{1, 2, 3, 4, 5, 8, 13} {
+			leaves := make([]bigutil.Uint256, n)
+			for idx := range leaves {
+				leaves[idx] = bigutil.Keccak256ToUint256([]byte{byte(idx)})
+			}
+
+			root, err := bigutil.MerkleRoot(leaves)
+			require.Nil(t, err)
+
+			for idx, leaf := range leaves {
+				proof, err := bigutil.MerkleProof(leaves, idx)
+				require.Nil(t, err)
+
+				require.True(t, bigutil.VerifyMerkleProof(proof, root, leaf))
+				require.False(t, bigutil.VerifyMerkleProof(proof, root, bigutil.Keccak256ToUint256([]byte("other"))))
+			}
+		}
+	})
+
+	t.Run("internal node as leaf", func(t *testing.T) {
+		leaves := uint256s(1, 2, 3, 4)
+
+		root, err := bigutil.MerkleRoot(leaves)
+		require.Nil(t, err)
+
+		// The parent of the first two leaves, with the proof of its sibling, must not verify as a leaf.
+		node := bigutil.HashPair(bigutil.HashLeaf(leaves[0]), bigutil.HashLeaf(leaves[1]))
+		sibling := bigutil.HashPair(bigutil.HashLeaf(leaves[2]), bigutil.HashLeaf(leaves[3]))
+		require.False(t, bigutil.VerifyMerkleProof([]bigutil.Uint256{sibling}, root, node))
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		_, err := bigutil.MerkleProof(uint256s(1, 2), 2)
+		require.Error(t, err)
+
+		_, err = bigutil.MerkleProof(uint256s(1, 2), -1)
+		require.Error(t, err)
+	})
+}
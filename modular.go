@@ -0,0 +1,57 @@
+package bigutil
+
+// AddMod returns (x + y) mod m without truncating the intermediate sum, as the EVM ADDMOD opcode does.
+// It returns zero if m is zero.
+func AddMod(x, y, m Uint256) Uint256 {
+	r := Uint256{}
+	if m.x.Sign() == 0 {
+		return r
+	}
+
+	r.x.Add(&x.x, &y.x)
+	r.x.Rem(&r.x, &m.x)
+
+	return r
+}
+
+// MulMod returns (x * y) mod m without truncating the intermediate product, as the EVM MULMOD opcode does.
+// It returns zero if m is zero.
+func MulMod(x, y, m Uint256) Uint256 {
+	r := Uint256{}
+	if m.x.Sign() == 0 {
+		return r
+	}
+
+	r.x.Mul(&x.x, &y.x)
+	r.x.Rem(&r.x, &m.x)
+
+	return r
+}
+
+// ExpMod returns base^exp mod modulus.
+// It returns zero if modulus is zero, as the EIP-198 MODEXP precompile does.
+func ExpMod(base, exp, modulus Uint256) Uint256 {
+	r := Uint256{}
+	if modulus.x.Sign() == 0 {
+		return r
+	}
+
+	r.x.Exp(&base.x, &exp.x, &modulus.x)
+
+	return r
+}
+
+// ModInverse returns the multiplicative inverse of i modulo m.
+// It returns an error if m is zero or if i and m are not coprime, in which case no inverse exists.
+func (i Uint256) ModInverse(m Uint256) (Uint256, error) {
+	if m.x.Sign() == 0 {
+		return Uint256{}, errorf("modulus must not be zero")
+	}
+
+	r := Uint256{}
+	if r.x.ModInverse(&i.x, &m.x) == nil {
+		return Uint256{}, errorf("value must be coprime to the modulus")
+	}
+
+	return r, nil
+}
@@ -0,0 +1,201 @@
+package bigutil_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+)
+
+func TestModular(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			fn   func(x, y, m bigutil.Uint256) bigutil.Uint256
+			x    bigutil.Uint256
+			y    bigutil.Uint256
+			m    bigutil.Uint256
+			out  bigutil.Uint256
+		}{
+			{
+				"add mod",
+				bigutil.AddMod,
+				bigutil.Uint64ToUint256(5),
+				bigutil.Uint64ToUint256(6),
+				bigutil.Uint64ToUint256(7),
+				bigutil.Uint64ToUint256(4),
+			},
+			{
+				"add mod: full width",
+				bigutil.AddMod,
+				bigutil.MaxUint256(),
+				bigutil.Uint64ToUint256(2),
+				bigutil.MaxUint256(),
+				bigutil.Uint64ToUint256(2),
+			},
+			{
+				"add mod: zero modulus",
+				bigutil.AddMod,
+				bigutil.One(),
+				bigutil.One(),
+				bigutil.Zero(),
+				bigutil.Zero(),
+			},
+			{
+				"mul mod",
+				bigutil.MulMod,
+				bigutil.Uint64ToUint256(5),
+				bigutil.Uint64ToUint256(6),
+				bigutil.Uint64ToUint256(7),
+				bigutil.Uint64ToUint256(2),
+			},
+			{
+				"mul mod: full width",
+				bigutil.MulMod,
+				bigutil.MaxUint256(),
+				bigutil.MaxUint256(),
+				bigutil.Uint64ToUint256(12),
+				bigutil.Uint64ToUint256(9),
+			},
+			{
+				"mul mod: zero modulus",
+				bigutil.MulMod,
+				bigutil.One(),
+				bigutil.One(),
+				bigutil.Zero(),
+				bigutil.Zero(),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Zero(t, tc.fn(tc.x, tc.y, tc.m).BigInt().Cmp(tc.out.BigInt()))
+			})
+		}
+	})
+}
+
+func TestExpMod(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name    string
+			base    bigutil.Uint256
+			exp     bigutil.Uint256
+			modulus bigutil.Uint256
+			out     bigutil.Uint256
+		}{
+			{
+				"small",
+				bigutil.Uint64ToUint256(4),
+				bigutil.Uint64ToUint256(13),
+				bigutil.Uint64ToUint256(497),
+				bigutil.Uint64ToUint256(445),
+			},
+			{
+				"fermat",
+				bigutil.Uint64ToUint256(3),
+				bigutil.MustHexToUint256("0x30644e72e131a029b85045b68181585d2833e84879b9709143e1f593f0000000"),
+				bigutil.MustHexToUint256("0x30644e72e131a029b85045b68181585d2833e84879b9709143e1f593f0000001"),
+				bigutil.One(),
+			},
+			{
+				"zero exponent",
+				bigutil.Uint64ToUint256(5),
+				bigutil.Zero(),
+				bigutil.Uint64ToUint256(7),
+				bigutil.One(),
+			},
+			{
+				"modulus one",
+				bigutil.Uint64ToUint256(5),
+				bigutil.Zero(),
+				bigutil.One(),
+				bigutil.Zero(),
+			},
+			{
+				"zero modulus",
+				bigutil.Uint64ToUint256(5),
+				bigutil.Uint64ToUint256(2),
+				bigutil.Zero(),
+				bigutil.Zero(),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Zero(t, bigutil.ExpMod(tc.base, tc.exp, tc.modulus).BigInt().Cmp(tc.out.BigInt()))
+			})
+		}
+	})
+}
+
+func TestUint256ModInverse(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   bigutil.Uint256
+			m    bigutil.Uint256
+			out  bigutil.Uint256
+		}{
+			{
+				"small",
+				bigutil.Uint64ToUint256(3),
+				bigutil.Uint64ToUint256(11),
+				bigutil.Uint64ToUint256(4),
+			},
+			{
+				"greater than modulus",
+				bigutil.Uint64ToUint256(14),
+				bigutil.Uint64ToUint256(11),
+				bigutil.Uint64ToUint256(4),
+			},
+			{
+				"max modulus",
+				bigutil.Uint64ToUint256(2),
+				bigutil.MaxUint256(),
+				bigutil.MustHexToUint256("0x8000000000000000000000000000000000000000000000000000000000000000"),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				out, err := tc.in.ModInverse(tc.m)
+				require.Nil(t, err)
+
+				require.Zero(t, out.BigInt().Cmp(tc.out.BigInt()))
+			})
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   bigutil.Uint256
+			m    bigutil.Uint256
+		}{
+			{
+				"zero modulus",
+				bigutil.Uint64ToUint256(3),
+				bigutil.Zero(),
+			},
+			{
+				"not coprime",
+				bigutil.Uint64ToUint256(4),
+				bigutil.Uint64ToUint256(8),
+			},
+			{
+				"zero",
+				bigutil.Zero(),
+				bigutil.Uint64ToUint256(7),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := tc.in.ModInverse(tc.m)
+				require.Error(t, err)
+			})
+		}
+	})
+}
@@ -0,0 +1,100 @@
+package bigutil
+
+import "math/big"
+
+// Rounding is how the quotient of a division is rounded.
+type Rounding int
+
+const (
+	// RoundFloor rounds towards zero.
+	RoundFloor Rounding = iota
+	// RoundCeil rounds away from zero.
+	RoundCeil
+	// RoundHalfUp rounds to the nearest integer, and halves away from zero.
+	RoundHalfUp
+	// RoundHalfEven rounds to the nearest integer, and halves to the even one.
+	RoundHalfEven
+)
+
+// String implements the fmt.Stringer interface.
+func (r Rounding) String() string {
+	switch r {
+	case RoundFloor:
+		return "floor"
+	case RoundCeil:
+		return "ceil"
+	case RoundHalfUp:
+		return "half up"
+	case RoundHalfEven:
+		return "half even"
+	default:
+		return "invalid"
+	}
+}
+
+func (r Rounding) valid() bool {
+	return RoundFloor <= r && r <= RoundHalfEven
+}
+
+// MulDiv returns a * b / denominator, rounded with the given mode.
+// The product is computed at full precision, so it never overflows by itself.
+// It returns an error if denominator is zero, the rounding mode is invalid, or the result overflows.
+func MulDiv(a, b, denominator Uint256, rounding Rounding) (Uint256, error) {
+	if denominator.x.Sign() == 0 {
+		return Uint256{}, errorf("denominator must not be zero")
+	}
+
+	r := Uint256{}
+	r.x.Mul(&a.x, &b.x)
+
+	if err := quoRound(&r.x, &r.x, &denominator.x, rounding); err != nil {
+		return Uint256{}, err
+	}
+	if r.x.BitLen() > maxBitLength {
+		return Uint256{}, errorf("result must be less than or equal to %d bits", maxBitLength)
+	}
+
+	return r, nil
+}
+
+// DivRound returns i / j, rounded with the given mode.
+// It returns an error if j is zero or the rounding mode is invalid.
+func (i Uint256) DivRound(j Uint256, rounding Rounding) (Uint256, error) {
+	if j.x.Sign() == 0 {
+		return Uint256{}, errorf("divisor must not be zero")
+	}
+
+	r := Uint256{}
+	if err := quoRound(&r.x, &i.x, &j.x, rounding); err != nil {
+		return Uint256{}, err
+	}
+
+	return r, nil
+}
+
+// quoRound sets z to n / d rounded with the given mode.
+// n and d must be non-negative and d must not be zero.
+func quoRound(z, n, d *big.Int, rounding Rounding) error {
+	var rem big.Int
+	z.QuoRem(n, d, &rem)
+
+	var up bool
+	switch rounding {
+	case RoundFloor:
+	case RoundCeil:
+		up = rem.Sign() != 0
+	case RoundHalfUp:
+		up = rem.Lsh(&rem, 1).Cmp(d) >= 0
+	case RoundHalfEven:
+		c := rem.Lsh(&rem, 1).Cmp(d)
+		up = c > 0 || c == 0 && z.Bit(0) == 1
+	default:
+		return errorf("rounding must be valid")
+	}
+
+	if up {
+		z.Add(z, big.NewInt(1))
+	}
+
+	return nil
+}
@@ -0,0 +1,256 @@
+package bigutil_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+)
+
+func TestRoundingString(t *testing.T) {
+	require.Equal(t, "floor", bigutil.RoundFloor.String())
+	require.Equal(t, "ceil", bigutil.RoundCeil.String())
+	require.Equal(t, "half up", bigutil.RoundHalfUp.String())
+	require.Equal(t, "half even", bigutil.RoundHalfEven.String())
+	require.Equal(t, "invalid", bigutil.Rounding(-1).String())
+}
+
+func TestMulDiv(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name        string
+			a           bigutil.Uint256
+			b           bigutil.Uint256
+			denominator bigutil.Uint256
+			rounding    bigutil.Rounding
+			out         bigutil.Uint256
+		}{
+			{
+				"floor",
+				bigutil.Uint64ToUint256(7),
+				bigutil.Uint64ToUint256(3),
+				bigutil.Uint64ToUint256(4),
+				bigutil.RoundFloor,
+				bigutil.Uint64ToUint256(5),
+			},
+			{
+				"ceil",
+				bigutil.Uint64ToUint256(7),
+				bigutil.Uint64ToUint256(3),
+				bigutil.Uint64ToUint256(4),
+				bigutil.RoundCeil,
+				bigutil.Uint64ToUint256(6),
+			},
+			{
+				"ceil: exact",
+				bigutil.Uint64ToUint256(8),
+				bigutil.Uint64ToUint256(3),
+				bigutil.Uint64ToUint256(4),
+				bigutil.RoundCeil,
+				bigutil.Uint64ToUint256(6),
+			},
+			{
+				"half up: below half",
+				bigutil.Uint64ToUint256(1),
+				bigutil.Uint64ToUint256(7),
+				bigutil.Uint64ToUint256(5),
+				bigutil.RoundHalfUp,
+				bigutil.Uint64ToUint256(1),
+			},
+			{
+				"half up: half",
+				bigutil.Uint64ToUint256(5),
+				bigutil.Uint64ToUint256(1),
+				bigutil.Uint64ToUint256(2),
+				bigutil.RoundHalfUp,
+				bigutil.Uint64ToUint256(3),
+			},
+			{
+				"full precision",
+				bigutil.MaxUint256(),
+				bigutil.MaxUint256(),
+				bigutil.MaxUint256(),
+				bigutil.RoundFloor,
+				bigutil.MaxUint256(),
+			},
+			{
+				"ceil: max",
+				bigutil.MaxUint256(),
+				bigutil.Uint64ToUint256(2),
+				bigutil.Uint64ToUint256(2),
+				bigutil.RoundCeil,
+				bigutil.MaxUint256(),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				out, err := bigutil.MulDiv(tc.a, tc.b, tc.denominator, tc.rounding)
+				require.Nil(t, err)
+
+				require.Zero(t, out.BigInt().Cmp(tc.out.BigInt()))
+			})
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name        string
+			a           bigutil.Uint256
+			b           bigutil.Uint256
+			denominator bigutil.Uint256
+			rounding    bigutil.Rounding
+		}{
+			{
+				"zero denominator",
+				bigutil.One(),
+				bigutil.One(),
+				bigutil.Zero(),
+				bigutil.RoundFloor,
+			},
+			{
+				"overflow",
+				bigutil.MaxUint256(),
+				bigutil.Uint64ToUint256(2),
+				bigutil.One(),
+				bigutil.RoundFloor,
+			},
+			{
+				"overflow: ceil",
+				bigutil.MaxUint256(),
+				bigutil.Uint64ToUint256(3),
+				bigutil.Uint64ToUint256(2),
+				bigutil.RoundCeil,
+			},
+			{
+				"invalid rounding",
+				bigutil.One(),
+				bigutil.One(),
+				bigutil.One(),
+				bigutil.Rounding(-1),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := bigutil.MulDiv(tc.a, tc.b, tc.denominator, tc.rounding)
+				require.Error(t, err)
+			})
+		}
+	})
+}
+
+func TestUint256DivRound(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name     string
+			i        bigutil.Uint256
+			j        bigutil.Uint256
+			rounding bigutil.Rounding
+			out      bigutil.Uint256
+		}{
+			{
+				"floor",
+				bigutil.Uint64ToUint256(7),
+				bigutil.Uint64ToUint256(2),
+				bigutil.RoundFloor,
+				bigutil.Uint64ToUint256(3),
+			},
+			{
+				"ceil",
+				bigutil.Uint64ToUint256(7),
+				bigutil.Uint64ToUint256(2),
+				bigutil.RoundCeil,
+				bigutil.Uint64ToUint256(4),
+			},
+			{
+				"ceil: max",
+				bigutil.MaxUint256(),
+				bigutil.One(),
+				bigutil.RoundCeil,
+				bigutil.MaxUint256(),
+			},
+			{
+				"ceil: max divisor",
+				bigutil.One(),
+				bigutil.MaxUint256(),
+				bigutil.RoundCeil,
+				bigutil.One(),
+			},
+			{
+				"half up: half",
+				bigutil.Uint64ToUint256(5),
+				bigutil.Uint64ToUint256(2),
+				bigutil.RoundHalfUp,
+				bigutil.Uint64ToUint256(3),
+			},
+			{
+				"half even: half to even below",
+				bigutil.Uint64ToUint256(5),
+				bigutil.Uint64ToUint256(2),
+				bigutil.RoundHalfEven,
+				bigutil.Uint64ToUint256(2),
+			},
+			{
+				"half even: half to even above",
+				bigutil.Uint64ToUint256(7),
+				bigutil.Uint64ToUint256(2),
+				bigutil.RoundHalfEven,
+				bigutil.Uint64ToUint256(4),
+			},
+			{
+				"half even: above half",
+				bigutil.Uint64ToUint256(5),
+				bigutil.Uint64ToUint256(3),
+				bigutil.RoundHalfEven,
+				bigutil.Uint64ToUint256(2),
+			},
+			{
+				"half even: below half",
+				bigutil.Uint64ToUint256(4),
+				bigutil.Uint64ToUint256(3),
+				bigutil.RoundHalfEven,
+				bigutil.One(),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				out, err := tc.i.DivRound(tc.j, tc.rounding)
+				require.Nil(t, err)
+
+				require.Zero(t, out.BigInt().Cmp(tc.out.BigInt()))
+			})
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name     string
+			i        bigutil.Uint256
+			j        bigutil.Uint256
+			rounding bigutil.Rounding
+		}{
+			{
+				"zero divisor",
+				bigutil.One(),
+				bigutil.Zero(),
+				bigutil.RoundFloor,
+			},
+			{
+				"invalid rounding",
+				bigutil.One(),
+				bigutil.One(),
+				bigutil.Rounding(-1),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := tc.i.DivRound(tc.j, tc.rounding)
+				require.Error(t, err)
+			})
+		}
+	})
+}
@@ -0,0 +1,61 @@
+package bigutil
+
+// NullBehavior is how a null input (JSON null or SQL NULL) is decoded.
+type NullBehavior int
+
+const (
+	// NullAsError rejects null with an error.
+	NullAsError NullBehavior = iota
+	// NullAsZero decodes null as zero.
+	NullAsZero
+	// NullAsUnchanged leaves the destination unchanged, as encoding/json does for most types.
+	NullAsUnchanged
+)
+
+// FormatWhitelist is the set of formats accepted when parsing text.
+type FormatWhitelist int
+
+const (
+	// AcceptAny accepts both hex and decimal strings.
+	AcceptAny FormatWhitelist = iota
+	// AcceptHexOnly accepts only 0x-prefixed hex strings.
+	AcceptHexOnly
+	// AcceptDecimalOnly accepts only decimal strings.
+	AcceptDecimalOnly
+)
+
+// StrictParsing makes UnmarshalText (and UnmarshalJSON) accept only canonical forms:
+// a 0x-prefixed hex string without leading zero digits,
+// or a decimal string without signs, base prefixes, underscores and leading zero digits.
+// It must not be changed concurrently with parsing; set it during program initialization.
+var StrictParsing = false
+
+// AcceptedFormats narrows the formats accepted by UnmarshalText, UnmarshalJSON and UnmarshalGQL,
+// e.g. to reject decimal strings that could be confused with IDs.
+// It must not be changed concurrently with parsing; set it during program initialization.
+var AcceptedFormats = AcceptAny
+
+// StrictScan makes Scan accept only exactly 32-byte values, e.g. for BINARY(32) columns,
+// and makes Value write exactly 32 bytes accordingly, so that written values can be scanned back.
+// It must not be changed concurrently with scanning or writing; set it during program initialization.
+var StrictScan = false
+
+// JSONNull is how UnmarshalJSON decodes a JSON null, e.g. from third-party APIs that emit null for absent amounts.
+// It must not be changed concurrently with parsing; set it during program initialization.
+var JSONNull = NullAsError
+
+// SQLNull is how Scan decodes a SQL NULL, e.g. for legacy nullable columns where NULL means zero.
+// It must not be changed concurrently with scanning; set it during program initialization.
+var SQLNull = NullAsError
+
+// GQLNumberLiteral makes MarshalGQL emit an unquoted decimal number literal instead of a quoted hex string,
+// for schemas that declare the scalar as a BigInt-style number.
+// Note that many clients, including JavaScript ones, parse number literals as float64
+// and silently lose precision above 2^53, so prefer the default unless the clients are known to handle it.
+// It must not be changed concurrently with marshaling; set it during program initialization.
+var GQLNumberLiteral = false
+
+// RedactErrors omits the offending input from parse and scan errors, for privacy-sensitive deployments.
+// By default, error messages include the input, truncated if it is too long.
+// It must not be changed concurrently with parsing and scanning; set it during program initialization.
+var RedactErrors = false
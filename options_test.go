@@ -0,0 +1,392 @@
+package bigutil_test
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+)
+
+func TestStrictParsing(t *testing.T) {
+	bigutil.StrictParsing = true
+	t.Cleanup(func() {
+		bigutil.StrictParsing = false
+	})
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   string
+			out  bigutil.Uint256
+		}{
+			{
+				"hex",
+				"0xff",
+				bigutil.Uint64ToUint256(255),
+			},
+			{
+				"decimal",
+				"255",
+				bigutil.Uint64ToUint256(255),
+			},
+			{
+				"zero",
+				"0",
+				bigutil.Uint64ToUint256(0),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var i bigutil.Uint256
+				require.Nil(t, i.UnmarshalText([]byte(tc.in)))
+
+				require.Zero(t, i.BigInt().Cmp(tc.out.BigInt()))
+			})
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   string
+		}{
+			{
+				"hex with leading zero digits",
+				"0x00ff",
+			},
+			{
+				"upper case prefix",
+				"0XFF",
+			},
+			{
+				"plus sign",
+				"+255",
+			},
+			{
+				"decimal with leading zero digits",
+				"0255",
+			},
+			{
+				"underscores",
+				"0b1_0",
+			},
+			{
+				"surrounding whitespace",
+				" 255 ",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var i bigutil.Uint256
+				require.Error(t, i.UnmarshalText([]byte(tc.in)))
+			})
+		}
+	})
+}
+
+func TestStrictScan(t *testing.T) {
+	bigutil.StrictScan = true
+	t.Cleanup(func() {
+		bigutil.StrictScan = false
+	})
+
+	t.Run("success", func(t *testing.T) {
+		b := make([]byte, 32)
+		b[31] = 0x1
+
+		var i bigutil.Uint256
+		require.Nil(t, i.Scan(b))
+
+		require.Zero(t, i.BigInt().Cmp(bigutil.Uint64ToUint256(1).BigInt()))
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		for _, in := range []bigutil.Uint256{bigutil.Zero(), bigutil.Uint64ToUint256(5), bigutil.MaxUint256()} {
+			v, err := in.Value()
+			require.Nil(t, err)
+			require.Len(t, v, 32)
+
+			var out bigutil.Uint256
+			require.Nil(t, out.Scan(v))
+
+			require.Zero(t, out.BigInt().Cmp(in.BigInt()))
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+		}{
+			{
+				"minimal",
+				[]byte{0x1},
+			},
+			{
+				"31 bytes",
+				make([]byte, 31),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var i bigutil.Uint256
+				require.Error(t, i.Scan(tc.in))
+			})
+		}
+	})
+}
+
+func TestJSONNull(t *testing.T) {
+	type payload struct {
+		Amount bigutil.Uint256 `json:"amount"`
+	}
+
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			null bigutil.NullBehavior
+			out  bigutil.Uint256
+		}{
+			{
+				"as zero",
+				bigutil.NullAsZero,
+				bigutil.Zero(),
+			},
+			{
+				"as unchanged",
+				bigutil.NullAsUnchanged,
+				bigutil.Uint64ToUint256(1),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				bigutil.JSONNull = tc.null
+				t.Cleanup(func() {
+					bigutil.JSONNull = bigutil.NullAsError
+				})
+
+				p := payload{bigutil.Uint64ToUint256(1)}
+				require.Nil(t, json.Unmarshal([]byte(`{"amount": null}`), &p))
+
+				require.Zero(t, p.Amount.BigInt().Cmp(tc.out.BigInt()))
+			})
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		var p payload
+		require.Error(t, json.Unmarshal([]byte(`{"amount": null}`), &p))
+	})
+}
+
+func TestSQLNull(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			null bigutil.NullBehavior
+			out  bigutil.Uint256
+		}{
+			{
+				"as zero",
+				bigutil.NullAsZero,
+				bigutil.Zero(),
+			},
+			{
+				"as unchanged",
+				bigutil.NullAsUnchanged,
+				bigutil.Uint64ToUint256(1),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				bigutil.SQLNull = tc.null
+				t.Cleanup(func() {
+					bigutil.SQLNull = bigutil.NullAsError
+				})
+
+				i := bigutil.Uint64ToUint256(1)
+				require.Nil(t, i.Scan(nil))
+
+				require.Zero(t, i.BigInt().Cmp(tc.out.BigInt()))
+			})
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		var i bigutil.Uint256
+		require.Error(t, i.Scan(nil))
+	})
+}
+
+func TestAcceptedFormats(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name   string
+			accept bigutil.FormatWhitelist
+			in     string
+		}{
+			{
+				"any: hex",
+				bigutil.AcceptAny,
+				`"0xff"`,
+			},
+			{
+				"any: decimal",
+				bigutil.AcceptAny,
+				`"255"`,
+			},
+			{
+				"hex only",
+				bigutil.AcceptHexOnly,
+				`"0xff"`,
+			},
+			{
+				"decimal only: string",
+				bigutil.AcceptDecimalOnly,
+				`"255"`,
+			},
+			{
+				"decimal only: number",
+				bigutil.AcceptDecimalOnly,
+				`255`,
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				bigutil.AcceptedFormats = tc.accept
+				t.Cleanup(func() {
+					bigutil.AcceptedFormats = bigutil.AcceptAny
+				})
+
+				var i bigutil.Uint256
+				require.Nil(t, json.Unmarshal([]byte(tc.in), &i))
+
+				require.True(t, i.EqualUint64(255))
+			})
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name   string
+			accept bigutil.FormatWhitelist
+			in     string
+			reason bigutil.ParseErrorReason
+		}{
+			{
+				"hex only: decimal string",
+				bigutil.AcceptHexOnly,
+				`"255"`,
+				bigutil.ReasonMissingPrefix,
+			},
+			{
+				"hex only: number",
+				bigutil.AcceptHexOnly,
+				`255`,
+				bigutil.ReasonMissingPrefix,
+			},
+			{
+				"decimal only: hex",
+				bigutil.AcceptDecimalOnly,
+				`"0xff"`,
+				bigutil.ReasonHexNotAllowed,
+			},
+			{
+				"decimal only: upper case hex",
+				bigutil.AcceptDecimalOnly,
+				`"0X1f"`,
+				bigutil.ReasonHexNotAllowed,
+			},
+			{
+				"decimal only: binary",
+				bigutil.AcceptDecimalOnly,
+				`"0b101"`,
+				bigutil.ReasonLeadingZero,
+			},
+			{
+				"decimal only: octal",
+				bigutil.AcceptDecimalOnly,
+				`"0o17"`,
+				bigutil.ReasonLeadingZero,
+			},
+			{
+				"decimal only: underscores",
+				bigutil.AcceptDecimalOnly,
+				`"1_000"`,
+				bigutil.ReasonInvalidDigit,
+			},
+			{
+				"decimal only: leading zero",
+				bigutil.AcceptDecimalOnly,
+				`"017"`,
+				bigutil.ReasonLeadingZero,
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				bigutil.AcceptedFormats = tc.accept
+				t.Cleanup(func() {
+					bigutil.AcceptedFormats = bigutil.AcceptAny
+				})
+
+				var i bigutil.Uint256
+				err := json.Unmarshal([]byte(tc.in), &i)
+
+				var parseErr *bigutil.ParseError
+				require.True(t, errors.As(err, &parseErr))
+				require.Equal(t, tc.reason, parseErr.Reason)
+
+				_, err = bigutil.DecodeJSONArray(json.NewDecoder(strings.NewReader("[" + tc.in + "]")))
+				require.Error(t, err)
+			})
+		}
+
+		t.Run("gql", func(t *testing.T) {
+			bigutil.AcceptedFormats = bigutil.AcceptHexOnly
+			t.Cleanup(func() {
+				bigutil.AcceptedFormats = bigutil.AcceptAny
+			})
+
+			var i bigutil.Uint256
+			require.Error(t, i.UnmarshalGQL(255))
+			require.Error(t, i.UnmarshalGQL("255"))
+		})
+	})
+}
+
+func TestRedactErrors(t *testing.T) {
+	bigutil.RedactErrors = true
+	t.Cleanup(func() {
+		bigutil.RedactErrors = false
+	})
+
+	t.Run("success", func(t *testing.T) {
+		var i bigutil.Uint256
+
+		err := i.UnmarshalText([]byte("0xsecret"))
+		require.EqualError(t, err, "invalid digit (offset 2)")
+
+		var parseErr *bigutil.ParseError
+		require.True(t, errors.As(err, &parseErr))
+		require.Empty(t, parseErr.Input)
+
+		err = i.Scan(make([]byte, 33))
+		require.Error(t, err)
+		require.NotContains(t, err.Error(), "00")
+
+		err = i.Scan("secret")
+		require.Error(t, err)
+		require.NotContains(t, err.Error(), "secret")
+	})
+}
@@ -0,0 +1,132 @@
+package bigutil
+
+import (
+	"math/big"
+)
+
+// Field is an integer placed at a bit range inside a packed Uint256,
+// as Solidity packs storage variables smaller than 32 bytes into one slot.
+type Field struct {
+	// Offset is the position of the least significant bit of the field, counted from the least significant bit of the word.
+	Offset uint
+	// Width is the number of bits of the field.
+	Width uint
+	// Value is the value of the field.
+	Value Uint256
+}
+
+// Pack returns the Uint256 with the given fields placed at their bit ranges and all other bits zero.
+// It returns an error if a field is out of the 256-bit range, overlaps another one, or has a value that does not fit in its width.
+func Pack(fields ...Field) (Uint256, error) {
+	if err := validateFields(fields); err != nil {
+		return Uint256{}, err
+	}
+
+	i := Uint256{}
+	var shifted big.Int
+	for idx, f := range fields {
+		if f.Value.x.BitLen() > int(f.Width) {
+			return Uint256{}, &IndexError{idx, errorf("value must be less than or equal to %d bits", f.Width)}
+		}
+
+		i.x.Or(&i.x, shifted.Lsh(&f.Value.x, f.Offset))
+	}
+
+	return i, nil
+}
+
+// ExtractBits returns the width-bit field of i whose least significant bit is at offset.
+// It returns an error if the field is empty or out of the 256-bit range.
+func (i Uint256) ExtractBits(offset, width uint) (Uint256, error) {
+	f := Field{Offset: offset, Width: width}
+	if err := validateField(f); err != nil {
+		return Uint256{}, err
+	}
+
+	return extractField(i, f), nil
+}
+
+// InsertBits returns i with the width-bit field whose least significant bit is at offset replaced by v.
+// It returns an error if the field is empty or out of the 256-bit range, or if v does not fit in width bits.
+func (i Uint256) InsertBits(offset, width uint, v Uint256) (Uint256, error) {
+	f := Field{Offset: offset, Width: width, Value: v}
+	if err := validateField(f); err != nil {
+		return Uint256{}, err
+	}
+	if v.x.BitLen() > int(width) {
+		return Uint256{}, errorf("value must be less than or equal to %d bits", width)
+	}
+
+	mask := fieldMask(f)
+
+	r := Uint256{}
+	r.x.AndNot(&i.x, mask.Lsh(mask, offset))
+	r.x.Or(&r.x, new(big.Int).Lsh(&v.x, offset))
+
+	return r, nil
+}
+
+// Unpack returns the given fields with their values extracted from x.
+// The given values are ignored.
+// It returns an error if a field is out of the 256-bit range or overlaps another one.
+func Unpack(x Uint256, fields ...Field) ([]Field, error) {
+	if err := validateFields(fields); err != nil {
+		return nil, err
+	}
+
+	out := make([]Field, len(fields))
+	for idx, f := range fields {
+		out[idx] = Field{
+			Offset: f.Offset,
+			Width:  f.Width,
+			Value:  extractField(x, f),
+		}
+	}
+
+	return out, nil
+}
+
+// validateFields returns an *IndexError for the first field that is out of the 256-bit range or overlaps a preceding one.
+func validateFields(fields []Field) error {
+	for idx, f := range fields {
+		if err := validateField(f); err != nil {
+			return &IndexError{idx, err}
+		}
+
+		for k, g := range fields[:idx] {
+			if f.Offset < g.Offset+g.Width && g.Offset < f.Offset+f.Width {
+				return &IndexError{idx, errorf("field must not overlap field %d", k)}
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateField returns an error if the given field is empty or out of the 256-bit range.
+func validateField(f Field) error {
+	if f.Width == 0 {
+		return errorf("width must be positive")
+	}
+	if f.Offset+f.Width > maxBitLength || f.Offset+f.Width < f.Offset {
+		return errorf("field must be within %d bits", maxBitLength)
+	}
+
+	return nil
+}
+
+// extractField returns the value of the given field in x, which must be valid.
+func extractField(x Uint256, f Field) Uint256 {
+	v := Uint256{}
+	v.x.Rsh(&x.x, f.Offset)
+	v.x.And(&v.x, fieldMask(f))
+
+	return v
+}
+
+// fieldMask returns the mask of the width of the given field, not shifted to its offset.
+func fieldMask(f Field) *big.Int {
+	mask := new(big.Int).Lsh(big.NewInt(1), f.Width)
+
+	return mask.Sub(mask, big.NewInt(1))
+}
@@ -0,0 +1,292 @@
+package bigutil_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+)
+
+func TestPack(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// struct { uint64 a; address b; bool c; } packed into one slot.
+		fields := []bigutil.Field{
+			{
+				Offset: 0,
+				Width:  64,
+				Value:  bigutil.Uint64ToUint256(0x1122334455667788),
+			},
+			{
+				Offset: 64,
+				Width:  160,
+				Value:  bigutil.MustHexToUint256("0xffffffffffffffffffffffffffffffffffffffff"),
+			},
+			{
+				Offset: 224,
+				Width:  8,
+				Value:  bigutil.One(),
+			},
+		}
+
+		x, err := bigutil.Pack(fields...)
+		require.Nil(t, err)
+		require.Equal(t, "0x1ffffffffffffffffffffffffffffffffffffffff1122334455667788", x.String())
+
+		unpacked, err := bigutil.Unpack(x, fields...)
+		require.Nil(t, err)
+		require.Len(t, unpacked, len(fields))
+		for idx := range fields {
+			require.Equal(t, fields[idx].Offset, unpacked[idx].Offset)
+			require.Equal(t, fields[idx].Width, unpacked[idx].Width)
+			require.Equal(t, fields[idx].Value.String(), unpacked[idx].Value.String())
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name   string
+			fields []bigutil.Field
+			index  int
+		}{
+			{
+				"zero width",
+				[]bigutil.Field{
+					{
+						Offset: 0,
+						Width:  0,
+					},
+				},
+				0,
+			},
+			{
+				"out of range",
+				[]bigutil.Field{
+					{
+						Offset: 0,
+						Width:  8,
+					},
+					{
+						Offset: 250,
+						Width:  8,
+					},
+				},
+				1,
+			},
+			{
+				"overlap",
+				[]bigutil.Field{
+					{
+						Offset: 0,
+						Width:  8,
+					},
+					{
+						Offset: 16,
+						Width:  8,
+					},
+					{
+						Offset: 7,
+						Width:  2,
+					},
+				},
+				2,
+			},
+			{
+				"value too large",
+				[]bigutil.Field{
+					{
+						Offset: 0,
+						Width:  8,
+						Value:  bigutil.Uint64ToUint256(256),
+					},
+				},
+				0,
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := bigutil.Pack(tc.fields...)
+				require.Error(t, err)
+
+				var idxErr *bigutil.IndexError
+				require.True(t, errors.As(err, &idxErr))
+				require.Equal(t, tc.index, idxErr.Index)
+			})
+		}
+	})
+}
+
+func TestUnpack(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		fields, err := bigutil.Unpack(bigutil.MaxUint256(), bigutil.Field{Offset: 252, Width: 4}, bigutil.Field{Offset: 0, Width: 1})
+		require.Nil(t, err)
+
+		require.True(t, fields[0].Value.EqualUint64(0xf))
+		require.True(t, fields[1].Value.EqualUint64(1))
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		_, err := bigutil.Unpack(bigutil.MaxUint256(), bigutil.Field{Offset: 0, Width: 8}, bigutil.Field{Offset: 4, Width: 8})
+		require.Error(t, err)
+	})
+}
+
+func TestUint256ExtractBits(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name   string
+			in     bigutil.Uint256
+			offset uint
+			width  uint
+			out    bigutil.Uint256
+		}{
+			{
+				"low byte",
+				bigutil.Uint64ToUint256(0xabcd),
+				0,
+				8,
+				bigutil.Uint64ToUint256(0xcd),
+			},
+			{
+				"middle",
+				bigutil.Uint64ToUint256(0xabcd),
+				4,
+				8,
+				bigutil.Uint64ToUint256(0xbc),
+			},
+			{
+				"full width",
+				bigutil.MaxUint256(),
+				0,
+				256,
+				bigutil.MaxUint256(),
+			},
+			{
+				"high bit",
+				bigutil.MaxUint256(),
+				255,
+				1,
+				bigutil.One(),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				out, err := tc.in.ExtractBits(tc.offset, tc.width)
+				require.Nil(t, err)
+
+				require.Zero(t, out.BigInt().Cmp(tc.out.BigInt()))
+			})
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name   string
+			offset uint
+			width  uint
+		}{
+			{
+				"zero width",
+				0,
+				0,
+			},
+			{
+				"out of range",
+				250,
+				8,
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := bigutil.MaxUint256().ExtractBits(tc.offset, tc.width)
+				require.Error(t, err)
+			})
+		}
+	})
+}
+
+func TestUint256InsertBits(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name   string
+			in     bigutil.Uint256
+			offset uint
+			width  uint
+			v      bigutil.Uint256
+			out    bigutil.Uint256
+		}{
+			{
+				"replace",
+				bigutil.Uint64ToUint256(0xabcd),
+				4,
+				8,
+				bigutil.Uint64ToUint256(0x12),
+				bigutil.Uint64ToUint256(0xa12d),
+			},
+			{
+				"clear",
+				bigutil.MaxUint256(),
+				8,
+				248,
+				bigutil.Zero(),
+				bigutil.Uint64ToUint256(0xff),
+			},
+			{
+				"high bit",
+				bigutil.Zero(),
+				255,
+				1,
+				bigutil.One(),
+				bigutil.MustHexToUint256("0x8000000000000000000000000000000000000000000000000000000000000000"),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				out, err := tc.in.InsertBits(tc.offset, tc.width, tc.v)
+				require.Nil(t, err)
+
+				require.Zero(t, out.BigInt().Cmp(tc.out.BigInt()))
+			})
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name   string
+			offset uint
+			width  uint
+			v      bigutil.Uint256
+		}{
+			{
+				"zero width",
+				0,
+				0,
+				bigutil.Zero(),
+			},
+			{
+				"out of range",
+				250,
+				8,
+				bigutil.Zero(),
+			},
+			{
+				"value too wide",
+				0,
+				8,
+				bigutil.Uint64ToUint256(0x100),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := bigutil.Zero().InsertBits(tc.offset, tc.width, tc.v)
+				require.Error(t, err)
+			})
+		}
+	})
+}
@@ -0,0 +1,59 @@
+package bigutil
+
+// Kind is the form of an input accepted by ParseAny.
+type Kind int
+
+const (
+	// KindInvalid is returned along with an error.
+	KindInvalid Kind = iota
+	// KindHex is a 0x-prefixed hex string, quoted or not.
+	KindHex
+	// KindDecimal is a quoted decimal string.
+	KindDecimal
+	// KindNumber is an unquoted decimal number.
+	KindNumber
+)
+
+// String implements the fmt.Stringer interface.
+func (k Kind) String() string {
+	switch k {
+	case KindHex:
+		return "hex"
+	case KindDecimal:
+		return "decimal"
+	case KindNumber:
+		return "number"
+	default:
+		return "invalid"
+	}
+}
+
+// ParseAny parses the given input in any of the forms accepted by UnmarshalJSON and UnmarshalText,
+// and reports which form it was in.
+// It never panics, which makes it suitable as a fuzzing entry point.
+func ParseAny(b []byte) (Uint256, Kind, error) {
+	kind := KindNumber
+	if unquoted, ok := unquote(b); ok {
+		b = unquoted
+		kind = KindDecimal
+	}
+	if isHexText(b) {
+		kind = KindHex
+	}
+
+	var i Uint256
+	if err := i.UnmarshalText(b); err != nil {
+		return Uint256{}, KindInvalid, err
+	}
+
+	return i, kind, nil
+}
+
+// unquote strips the surrounding double quotes from the given bytes, if any.
+func unquote(b []byte) ([]byte, bool) {
+	if len(b) >= 2 && b[0] == '"' && b[len(b)-1] == '"' {
+		return b[1 : len(b)-1], true
+	}
+
+	return b, false
+}
@@ -0,0 +1,113 @@
+package bigutil
+
+import (
+	"fmt"
+)
+
+const maxParseErrorInputLength = 80
+
+// ParseErrorReason is a machine-readable code describing why parsing failed.
+type ParseErrorReason string
+
+const (
+	// ReasonMissingPrefix is reported where a 0x-prefixed hex string is required but the input lacks the prefix, e.g. "ff" passed to HexToUint256.
+	ReasonMissingPrefix ParseErrorReason = "missing_prefix"
+	// ReasonHexNotAllowed is reported for 0x-prefixed input where only decimal is accepted.
+	ReasonHexNotAllowed ParseErrorReason = "hex_not_allowed"
+	// ReasonEmpty is reported for an empty string, or for "0x" without any digit.
+	ReasonEmpty ParseErrorReason = "empty"
+	// ReasonLeadingZero is reported for input with leading zero digits, e.g. "007", or "0x01" where canonical hex is required.
+	ReasonLeadingZero ParseErrorReason = "leading_zero"
+	// ReasonInvalidDigit is reported for a character that is not a digit of the base, e.g. "0xg" or "1_000".
+	ReasonInvalidDigit ParseErrorReason = "invalid_digit"
+	// ReasonNegative is reported for a negative decimal number, e.g. "-1".
+	ReasonNegative ParseErrorReason = "negative"
+	// ReasonOutOfRange is reported for a value that does not fit in 256 bits.
+	ReasonOutOfRange ParseErrorReason = "out_of_range"
+)
+
+var parseErrorMessages = map[ParseErrorReason]string{
+	ReasonMissingPrefix: "must have 0x prefix",
+	ReasonHexNotAllowed: "must not be hex",
+	ReasonEmpty:         "must not be empty",
+	ReasonLeadingZero:   "must not have leading zero digits",
+	ReasonInvalidDigit:  "invalid digit",
+	ReasonNegative:      "must be positive",
+	ReasonOutOfRange:    fmt.Sprintf("must be less than or equal to %d bits", maxBitLength),
+}
+
+// ParseError is returned when a textual representation cannot be parsed as Uint256.
+type ParseError struct {
+	// Input is the offending input, truncated if it is too long, or empty if RedactErrors is true.
+	Input string
+	// Offset is the byte offset of the first invalid character, or -1 if the error is not attributable to a single character.
+	Offset int
+	// Reason is the reason code.
+	Reason ParseErrorReason
+}
+
+func newParseError[T ~string | ~[]byte](input T, offset int, reason ParseErrorReason) *ParseError {
+	s := truncateInput(input)
+	if RedactErrors {
+		s = ""
+	}
+
+	return &ParseError{
+		Input:  s,
+		Offset: offset,
+		Reason: reason,
+	}
+}
+
+// truncateInput returns the given input as a string, truncated if it is too long.
+func truncateInput[T ~string | ~[]byte](input T) string {
+	s := string(input[:min(len(input), maxParseErrorInputLength)])
+	if len(input) > maxParseErrorInputLength {
+		s += "..."
+	}
+
+	return s
+}
+
+// inputSuffix returns the suffix that shows the given input in an error message,
+// or an empty string if RedactErrors is true.
+func inputSuffix(s string) string {
+	if RedactErrors {
+		return ""
+	}
+
+	return fmt.Sprintf(": %q", s)
+}
+
+// isHexText reports whether the given text has the 0x (or 0X) prefix of a hex string.
+func isHexText(text []byte) bool {
+	return len(text) >= 2 && text[0] == '0' && (text[1] == 'x' || text[1] == 'X')
+}
+
+// checkAccepted returns a *ParseError if the form of the given text is not allowed by the given whitelist.
+func checkAccepted(text []byte, accept FormatWhitelist) *ParseError {
+	isHex := isHexText(text)
+
+	switch {
+	case accept == AcceptHexOnly && !isHex:
+		return newParseError(text, 0, ReasonMissingPrefix)
+	case accept == AcceptDecimalOnly && isHex:
+		return newParseError(text, 0, ReasonHexNotAllowed)
+	default:
+		return nil
+	}
+}
+
+// Error implements the error interface.
+// The message includes the offending input unless RedactErrors is true.
+func (e *ParseError) Error() string {
+	msg, ok := parseErrorMessages[e.Reason]
+	if !ok {
+		msg = string(e.Reason)
+	}
+	if e.Offset >= 0 {
+		msg = fmt.Sprintf("%s (offset %d)", msg, e.Offset)
+	}
+
+	return msg + inputSuffix(e.Input)
+}
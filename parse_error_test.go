@@ -0,0 +1,115 @@
+package bigutil_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+)
+
+func TestParseError(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name   string
+			in     string
+			offset int
+			reason bigutil.ParseErrorReason
+		}{
+			{
+				"empty",
+				"",
+				0,
+				bigutil.ReasonEmpty,
+			},
+			{
+				"prefix only",
+				"0x",
+				2,
+				bigutil.ReasonEmpty,
+			},
+			{
+				"invalid hex digit",
+				"0x12z4",
+				4,
+				bigutil.ReasonInvalidDigit,
+			},
+			{
+				"invalid decimal digit",
+				"12z4",
+				2,
+				bigutil.ReasonInvalidDigit,
+			},
+			{
+				"negative",
+				"-1",
+				0,
+				bigutil.ReasonNegative,
+			},
+			{
+				"too large hex",
+				"0x1" + strings.Repeat("0", 64),
+				-1,
+				bigutil.ReasonOutOfRange,
+			},
+			{
+				"too large decimal",
+				"115792089237316195423570985008687907853269984665640564039457584007913129639936",
+				-1,
+				bigutil.ReasonOutOfRange,
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var i bigutil.Uint256
+				err := i.UnmarshalText([]byte(tc.in))
+
+				var parseErr *bigutil.ParseError
+				require.True(t, errors.As(err, &parseErr))
+				require.Equal(t, tc.offset, parseErr.Offset)
+				require.Equal(t, tc.reason, parseErr.Reason)
+			})
+		}
+	})
+
+	t.Run("truncated input", func(t *testing.T) {
+		_, err := bigutil.HexToUint256("0x" + strings.Repeat("f", 100) + "g")
+
+		var parseErr *bigutil.ParseError
+		require.True(t, errors.As(err, &parseErr))
+		require.Equal(t, bigutil.ReasonInvalidDigit, parseErr.Reason)
+		require.Equal(t, 102, parseErr.Offset)
+		require.Equal(t, "0x"+strings.Repeat("f", 78)+"...", parseErr.Input)
+	})
+}
+
+func TestParseErrorError(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   string
+			out  string
+		}{
+			{
+				"with offset",
+				"0x12z4",
+				`invalid digit (offset 4): "0x12z4"`,
+			},
+			{
+				"without offset",
+				"0x1" + strings.Repeat("0", 64),
+				`must be less than or equal to 256 bits: "0x1` + strings.Repeat("0", 64) + `"`,
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var i bigutil.Uint256
+				require.EqualError(t, i.UnmarshalText([]byte(tc.in)), tc.out)
+			})
+		}
+	})
+}
@@ -0,0 +1,124 @@
+package bigutil_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+)
+
+func TestParseAny(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+			out  bigutil.Uint256
+			kind bigutil.Kind
+		}{
+			{
+				"hexadecimal string",
+				[]byte(`"0xff"`),
+				bigutil.Uint64ToUint256(255),
+				bigutil.KindHex,
+			},
+			{
+				"unquoted hexadecimal string",
+				[]byte(`0x00ff`),
+				bigutil.Uint64ToUint256(255),
+				bigutil.KindHex,
+			},
+			{
+				"upper case hex prefix",
+				[]byte(`"0X10"`),
+				bigutil.Uint64ToUint256(16),
+				bigutil.KindHex,
+			},
+			{
+				"decimal string",
+				[]byte(`"255"`),
+				bigutil.Uint64ToUint256(255),
+				bigutil.KindDecimal,
+			},
+			{
+				"number",
+				[]byte(`115792089237316195423570985008687907853269984665640564039457584007913129639935`),
+				bigutil.MaxUint256(),
+				bigutil.KindNumber,
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				i, kind, err := bigutil.ParseAny(tc.in)
+				require.Nil(t, err)
+
+				require.Zero(t, i.BigInt().Cmp(tc.out.BigInt()))
+				require.Equal(t, tc.kind, kind)
+			})
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []byte
+		}{
+			{
+				"empty",
+				[]byte(``),
+			},
+			{
+				"single quote",
+				[]byte(`"`),
+			},
+			{
+				"negative",
+				[]byte(`-1`),
+			},
+			{
+				"invalid hex",
+				[]byte(`"0xg"`),
+			},
+			{
+				"leading zero",
+				[]byte(`"017"`),
+			},
+			{
+				"binary",
+				[]byte(`"0b11"`),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				_, kind, err := bigutil.ParseAny(tc.in)
+				require.Error(t, err)
+
+				require.Equal(t, bigutil.KindInvalid, kind)
+			})
+		}
+	})
+}
+
+func FuzzParseAny(f *testing.F) {
+	for _, s := range []string{`"0x0"`, `"0x00ff"`, `"255"`, `255`, `-1`, `"`, ``} {
+		f.Add([]byte(s))
+	}
+
+	f.Fuzz(func(t *testing.T, b []byte) {
+		i, _, err := bigutil.ParseAny(b)
+		if err != nil {
+			return
+		}
+
+		out, err := json.Marshal(i)
+		require.Nil(t, err)
+
+		j, kind, err := bigutil.ParseAny(out)
+		require.Nil(t, err)
+		require.Equal(t, bigutil.KindHex, kind)
+		require.Zero(t, i.BigInt().Cmp(j.BigInt()))
+	})
+}
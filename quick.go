@@ -0,0 +1,42 @@
+package bigutil
+
+import (
+	"math/big"
+	"math/rand"
+	"reflect"
+)
+
+// edgeValues are the values that Generate returns with higher probability than a uniform draw.
+var edgeValues = []*big.Int{
+	big.NewInt(0),
+	big.NewInt(1),
+	big.NewInt(2),
+	new(big.Int).SetUint64(1<<64 - 1),
+	new(big.Int).Lsh(big.NewInt(1), 64),
+	new(big.Int).Lsh(big.NewInt(1), 128),
+	new(big.Int).Lsh(big.NewInt(1), 255),
+	new(big.Int).Sub(maxBig256, big.NewInt(1)),
+	maxBig256,
+}
+
+// Generate implements the quick.Generator interface.
+// It returns one of the edge values (e.g. 0, 1 and the max value of uint256) with probability 1/4,
+// and a value drawn uniformly from the full uint256 range otherwise.
+func (Uint256) Generate(r *rand.Rand, _ int) reflect.Value {
+	i := Uint256{}
+
+	if r.Intn(4) == 0 {
+		i.x.Set(edgeValues[r.Intn(len(edgeValues))])
+	} else {
+		var b [maxByteLength]byte
+		for idx := 0; idx < maxByteLength; idx += 8 {
+			v := r.Uint64()
+			for k := 0; k < 8; k++ {
+				b[idx+k] = byte(v >> (8 * k))
+			}
+		}
+		i.x.SetBytes(b[:])
+	}
+
+	return reflect.ValueOf(i)
+}
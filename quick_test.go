@@ -0,0 +1,49 @@
+package bigutil_test
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+	"testing/quick"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+)
+
+func TestUint256Generate(t *testing.T) {
+	t.Run("in range", func(t *testing.T) {
+		require.Nil(t, quick.Check(func(i bigutil.Uint256) bool {
+			return i.BigInt().Sign() >= 0 && i.BigInt().BitLen() <= 256
+		}, nil))
+	})
+
+	t.Run("edge values", func(t *testing.T) {
+		r := rand.New(rand.NewSource(1))
+
+		var hasZero, hasMax bool
+		for range 1000 {
+			i := bigutil.Uint256{}.Generate(r, 0).Interface().(bigutil.Uint256)
+
+			hasZero = hasZero || i.BigInt().Sign() == 0
+			hasMax = hasMax || i.BigInt().Cmp(bigutil.MaxUint256().BigInt()) == 0
+		}
+
+		require.True(t, hasZero)
+		require.True(t, hasMax)
+	})
+
+	t.Run("large values", func(t *testing.T) {
+		r := rand.New(rand.NewSource(1))
+		threshold := new(big.Int).Lsh(big.NewInt(1), 192)
+
+		var hasLarge bool
+		for range 100 {
+			i := bigutil.Uint256{}.Generate(r, 0).Interface().(bigutil.Uint256)
+
+			hasLarge = hasLarge || i.BigInt().Cmp(threshold) > 0
+		}
+
+		require.True(t, hasLarge)
+	})
+}
@@ -0,0 +1,86 @@
+package bigutil
+
+import (
+	"encoding/binary"
+	"math/bits"
+	"math/rand/v2"
+)
+
+// RandomUint256 returns a uniformly distributed Uint256 drawn from the given source.
+// Both rand.Source implementations and *rand.Rand can be passed,
+// so seeded sources such as rand.NewPCG give reproducible sequences.
+// It is not suitable for cryptographic use unless the source is.
+func RandomUint256(src rand.Source) Uint256 {
+	var ws [4]uint64
+	for idx := range ws {
+		ws[idx] = src.Uint64()
+	}
+
+	return uint64sToUint256(ws)
+}
+
+// Uint256N returns a uniformly distributed Uint256 in [0, n) drawn from the given source,
+// as rand.N does for the built-in integer types.
+// It panics if n is zero.
+func Uint256N(src rand.Source, n Uint256) Uint256 {
+	if n.x.Sign() == 0 {
+		panic("n must be positive")
+	}
+
+	limit := sub1(n.uint64s())
+
+	// Draw only as many bits as needed, and reject draws not less than n.
+	var top int
+	for top < len(limit)-1 && limit[top] == 0 {
+		top++
+	}
+	mask := uint64(1)<<(64-bits.LeadingZeros64(limit[top])) - 1
+
+	for {
+		var ws [4]uint64
+		for idx := top; idx < len(ws); idx++ {
+			ws[idx] = src.Uint64()
+		}
+		ws[top] &= mask
+
+		if !greater(ws, limit) {
+			return uint64sToUint256(ws)
+		}
+	}
+}
+
+// uint64sToUint256 is the inverse of uint64s.
+func uint64sToUint256(ws [4]uint64) Uint256 {
+	var b [maxByteLength]byte
+	for idx, w := range ws {
+		binary.BigEndian.PutUint64(b[idx*8:], w)
+	}
+
+	i := Uint256{}
+	i.x.SetBytes(b[:])
+
+	return i
+}
+
+// sub1 returns ws - 1, where ws is not zero.
+func sub1(ws [4]uint64) [4]uint64 {
+	for idx := len(ws) - 1; idx >= 0; idx-- {
+		ws[idx]--
+		if ws[idx] != ^uint64(0) {
+			break
+		}
+	}
+
+	return ws
+}
+
+// greater reports whether x > y.
+func greater(x, y [4]uint64) bool {
+	for idx := range x {
+		if x[idx] != y[idx] {
+			return x[idx] > y[idx]
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,79 @@
+package bigutil_test
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+)
+
+func TestRandomUint256(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		x := bigutil.RandomUint256(rand.NewPCG(1, 2))
+		y := bigutil.RandomUint256(rand.New(rand.NewPCG(1, 2)))
+
+		require.Equal(t, x.String(), y.String())
+		require.Equal(t, 1, x.CmpUint64(1<<63))
+	})
+}
+
+func TestUint256N(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			n    bigutil.Uint256
+		}{
+			{
+				"one",
+				bigutil.One(),
+			},
+			{
+				"small",
+				bigutil.Uint64ToUint256(3),
+			},
+			{
+				"power of two",
+				bigutil.MustHexToUint256("0x10000000000000000"),
+			},
+			{
+				"large",
+				bigutil.MustHexToUint256("0x8000000000000000000000000000000000000000000000000000000000000001"),
+			},
+			{
+				"max",
+				bigutil.MaxUint256(),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				r := rand.New(rand.NewPCG(1, 2))
+
+				for range 1000 {
+					x := bigutil.Uint256N(r, tc.n)
+					require.Equal(t, -1, x.BigInt().Cmp(tc.n.BigInt()))
+				}
+			})
+		}
+
+		t.Run("uniform", func(t *testing.T) {
+			r := rand.New(rand.NewPCG(1, 2))
+
+			counts := make([]int, 3)
+			for range 3000 {
+				counts[bigutil.Uint256N(r, bigutil.Uint64ToUint256(3)).BigInt().Uint64()]++
+			}
+			for _, count := range counts {
+				require.InDelta(t, 1000, count, 100)
+			}
+		})
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		require.Panics(t, func() {
+			bigutil.Uint256N(rand.NewPCG(1, 2), bigutil.Zero())
+		})
+	})
+}
@@ -0,0 +1,84 @@
+package bigutil
+
+import (
+	"math/big"
+)
+
+// Range256 is an interval of Uint256 values.
+// Each bound is inclusive unless the corresponding exclusive flag is set.
+type Range256 struct {
+	Start          Uint256 `json:"start"`
+	End            Uint256 `json:"end"`
+	StartExclusive bool    `json:"startExclusive,omitempty"`
+	EndExclusive   bool    `json:"endExclusive,omitempty"`
+}
+
+// IsEmpty reports whether r contains no values.
+func (r Range256) IsEmpty() bool {
+	_, _, ok := r.bounds()
+
+	return !ok
+}
+
+// Contains reports whether r contains the given value.
+func (r Range256) Contains(i Uint256) bool {
+	lo, hi, ok := r.bounds()
+
+	return ok && lo.Cmp(&i.x) <= 0 && i.x.Cmp(hi) <= 0
+}
+
+// Overlaps reports whether r and the given Range256 have any value in common.
+func (r Range256) Overlaps(o Range256) bool {
+	_, ok := r.Intersect(o)
+
+	return ok
+}
+
+// Intersect returns the intersection of r and the given Range256 with inclusive bounds.
+// It returns false if the intersection is empty.
+func (r Range256) Intersect(o Range256) (Range256, bool) {
+	rlo, rhi, ok := r.bounds()
+	if !ok {
+		return Range256{}, false
+	}
+	olo, ohi, ok := o.bounds()
+	if !ok {
+		return Range256{}, false
+	}
+
+	lo, hi := rlo, rhi
+	if olo.Cmp(lo) > 0 {
+		lo = olo
+	}
+	if ohi.Cmp(hi) < 0 {
+		hi = ohi
+	}
+	if lo.Cmp(hi) > 0 {
+		return Range256{}, false
+	}
+
+	intersection := Range256{}
+	intersection.Start.x.Set(lo)
+	intersection.End.x.Set(hi)
+
+	return intersection, true
+}
+
+// bounds returns the inclusive bounds of r, or false if r is empty.
+func (r Range256) bounds() (*big.Int, *big.Int, bool) {
+	lo := new(big.Int).Set(&r.Start.x)
+	if r.StartExclusive {
+		lo.Add(lo, big.NewInt(1))
+	}
+
+	hi := new(big.Int).Set(&r.End.x)
+	if r.EndExclusive {
+		hi.Sub(hi, big.NewInt(1))
+	}
+
+	if lo.Cmp(hi) > 0 {
+		return nil, nil, false
+	}
+
+	return lo, hi, true
+}
@@ -0,0 +1,100 @@
+package bigutil_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+)
+
+func TestRange256Contains(t *testing.T) {
+	tcs := []struct {
+		name string
+		r    bigutil.Range256
+		in   bigutil.Uint256
+		out  bool
+	}{
+		{
+			"inclusive start",
+			bigutil.Range256{Start: bigutil.Uint64ToUint256(1), End: bigutil.Uint64ToUint256(3)},
+			bigutil.Uint64ToUint256(1),
+			true,
+		},
+		{
+			"exclusive start",
+			bigutil.Range256{Start: bigutil.Uint64ToUint256(1), End: bigutil.Uint64ToUint256(3), StartExclusive: true},
+			bigutil.Uint64ToUint256(1),
+			false,
+		},
+		{
+			"inclusive end",
+			bigutil.Range256{Start: bigutil.Uint64ToUint256(1), End: bigutil.Uint64ToUint256(3)},
+			bigutil.Uint64ToUint256(3),
+			true,
+		},
+		{
+			"exclusive end",
+			bigutil.Range256{Start: bigutil.Uint64ToUint256(1), End: bigutil.Uint64ToUint256(3), EndExclusive: true},
+			bigutil.Uint64ToUint256(3),
+			false,
+		},
+		{
+			"max",
+			bigutil.Range256{Start: bigutil.Uint64ToUint256(0), End: bigutil.MaxUint256()},
+			bigutil.MaxUint256(),
+			true,
+		},
+		{
+			"empty",
+			bigutil.Range256{Start: bigutil.Uint64ToUint256(0), End: bigutil.Uint64ToUint256(0), EndExclusive: true},
+			bigutil.Uint64ToUint256(0),
+			false,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.out, tc.r.Contains(tc.in))
+		})
+	}
+}
+
+func TestRange256Intersect(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		r := bigutil.Range256{Start: bigutil.Uint64ToUint256(1), End: bigutil.Uint64ToUint256(10), EndExclusive: true}
+		o := bigutil.Range256{Start: bigutil.Uint64ToUint256(5), End: bigutil.Uint64ToUint256(20), StartExclusive: true}
+
+		intersection, ok := r.Intersect(o)
+		require.True(t, ok)
+		require.True(t, r.Overlaps(o))
+
+		require.Zero(t, intersection.Start.BigInt().Cmp(bigutil.Uint64ToUint256(6).BigInt()))
+		require.Zero(t, intersection.End.BigInt().Cmp(bigutil.Uint64ToUint256(9).BigInt()))
+		require.False(t, intersection.StartExclusive)
+		require.False(t, intersection.EndExclusive)
+	})
+
+	t.Run("disjoint", func(t *testing.T) {
+		r := bigutil.Range256{Start: bigutil.Uint64ToUint256(1), End: bigutil.Uint64ToUint256(5), EndExclusive: true}
+		o := bigutil.Range256{Start: bigutil.Uint64ToUint256(5), End: bigutil.Uint64ToUint256(10)}
+
+		_, ok := r.Intersect(o)
+		require.False(t, ok)
+		require.False(t, r.Overlaps(o))
+	})
+}
+
+func TestRange256JSON(t *testing.T) {
+	r := bigutil.Range256{Start: bigutil.Uint64ToUint256(1), End: bigutil.Uint64ToUint256(255), EndExclusive: true}
+
+	b, err := json.Marshal(r)
+	require.Nil(t, err)
+	require.JSONEq(t, `{"start":"0x1","end":"0xff","endExclusive":true}`, string(b))
+
+	var decoded bigutil.Range256
+	require.Nil(t, json.Unmarshal(b, &decoded))
+	require.True(t, decoded.Contains(bigutil.Uint64ToUint256(254)))
+	require.False(t, decoded.Contains(bigutil.Uint64ToUint256(255)))
+}
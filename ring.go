@@ -0,0 +1,100 @@
+package bigutil
+
+import (
+	"encoding/binary"
+)
+
+// Ring is a consistent hashing ring that maps Uint256 keys to nodes.
+// Each node is placed at several points (virtual nodes) on the 256-bit keyspace,
+// and a key belongs to the node of the first point at or after it, wrapping around.
+// It is not safe for concurrent use.
+type Ring struct {
+	replicas int
+	hash     HashFunc
+	points   []Uint256
+	owners   map[[maxByteLength]byte]string
+	nodes    map[string]struct{}
+}
+
+// NewRing returns a new empty Ring that places each node at the given number of points,
+// derived with the given hash function. If hash is nil, Keccak256ToUint256 is used.
+// It returns an error if replicas is not positive.
+func NewRing(replicas int, hash HashFunc) (*Ring, error) {
+	if replicas <= 0 {
+		return nil, errorf("replicas must be positive")
+	}
+	if hash == nil {
+		hash = Keccak256ToUint256
+	}
+
+	return &Ring{
+		replicas: replicas,
+		hash:     hash,
+		owners:   make(map[[maxByteLength]byte]string),
+		nodes:    make(map[string]struct{}),
+	}, nil
+}
+
+// Add adds the given nodes. Nodes already in the ring are ignored.
+func (r *Ring) Add(nodes ...string) {
+	for _, node := range nodes {
+		if _, ok := r.nodes[node]; ok {
+			continue
+		}
+		r.nodes[node] = struct{}{}
+
+		for replica := range r.replicas {
+			p := r.point(node, replica)
+			r.points = append(r.points, p)
+			r.owners[p.bytes32()] = node
+		}
+	}
+
+	SortSlice(r.points)
+}
+
+// Remove removes the given node. It is a no-op if the node is not in the ring.
+func (r *Ring) Remove(node string) {
+	if _, ok := r.nodes[node]; !ok {
+		return
+	}
+	delete(r.nodes, node)
+
+	kept := r.points[:0]
+	for _, p := range r.points {
+		if b := p.bytes32(); r.owners[b] == node {
+			delete(r.owners, b)
+			continue
+		}
+		kept = append(kept, p)
+	}
+	clear(r.points[len(kept):])
+	r.points = kept
+}
+
+// Get returns the node that the given key belongs to.
+// It returns false if the ring is empty.
+func (r *Ring) Get(key Uint256) (string, bool) {
+	if len(r.points) == 0 {
+		return "", false
+	}
+
+	idx, _ := SearchSlice(r.points, key)
+	if idx == len(r.points) {
+		idx = 0
+	}
+
+	return r.owners[r.points[idx].bytes32()], true
+}
+
+// Len returns the number of nodes in the ring.
+func (r *Ring) Len() int {
+	return len(r.nodes)
+}
+
+func (r *Ring) point(node string, replica int) Uint256 {
+	var b [4]byte
+	binary.BigEndian.PutUint32(b[:], uint32(replica))
+
+	return r.hash([]byte(node), b[:])
+}
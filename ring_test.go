@@ -0,0 +1,104 @@
+package bigutil_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+)
+
+func TestNewRing(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		_, err := bigutil.NewRing(0, nil)
+		require.Error(t, err)
+	})
+}
+
+func TestRing(t *testing.T) {
+	key := func(n uint64) bigutil.Uint256 {
+		var b [8]byte
+		binary.BigEndian.PutUint64(b[:], n)
+
+		return bigutil.Keccak256ToUint256(b[:])
+	}
+
+	t.Run("success", func(t *testing.T) {
+		r, err := bigutil.NewRing(100, nil)
+		require.Nil(t, err)
+
+		_, ok := r.Get(key(0))
+		require.False(t, ok)
+
+		r.Add("a", "b", "c", "a")
+		require.Equal(t, 3, r.Len())
+
+		counts := make(map[string]int)
+		before := make(map[uint64]string)
+		for n := range uint64(3000) {
+			node, ok := r.Get(key(n))
+			require.True(t, ok)
+
+			counts[node]++
+			before[n] = node
+		}
+		for _, node := range []string{"a", "b", "c"} {
+			require.InDelta(t, 1000, counts[node], 300)
+		}
+
+		// Only the keys of the removed node move.
+		r.Remove("b")
+		r.Remove("unknown")
+		require.Equal(t, 2, r.Len())
+
+		for n := range uint64(3000) {
+			node, ok := r.Get(key(n))
+			require.True(t, ok)
+			require.NotEqual(t, "b", node)
+
+			if before[n] != "b" {
+				require.Equal(t, before[n], node)
+			}
+		}
+	})
+
+	t.Run("wrap around", func(t *testing.T) {
+		r, err := bigutil.NewRing(1, func(data ...[]byte) bigutil.Uint256 {
+			return bigutil.Uint64ToUint256(uint64(data[0][0]))
+		})
+		require.Nil(t, err)
+
+		r.Add("\x10", "\x20")
+
+		tcs := []struct {
+			name string
+			in   bigutil.Uint256
+			out  string
+		}{
+			{
+				"before first",
+				bigutil.Zero(),
+				"\x10",
+			},
+			{
+				"on point",
+				bigutil.Uint64ToUint256(0x20),
+				"\x20",
+			},
+			{
+				"after last",
+				bigutil.MaxUint256(),
+				"\x10",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				node, ok := r.Get(tc.in)
+				require.True(t, ok)
+				require.Equal(t, tc.out, node)
+			})
+		}
+	})
+}
@@ -0,0 +1,33 @@
+package bigutil
+
+import (
+	"math/big"
+)
+
+var secp256k1HalfOrder = new(big.Int).Rsh(&secp256k1Order.x, 1)
+
+// IsValidSecp256k1Scalar reports whether x is a valid secp256k1 scalar, i.e. 0 < x < n where n is the curve order.
+func IsValidSecp256k1Scalar(x Uint256) bool {
+	return x.x.Sign() > 0 && x.x.Cmp(&secp256k1Order.x) < 0
+}
+
+// IsLowS reports whether the given signature s value is in the lower half of the secp256k1 curve order.
+func IsLowS(s Uint256) bool {
+	return s.x.Cmp(secp256k1HalfOrder) <= 0
+}
+
+// NormalizeS returns the low-S form of the given signature s value, i.e. n - s if s > n/2, and s otherwise.
+// It returns an error if s is not a valid secp256k1 scalar.
+func NormalizeS(s Uint256) (Uint256, error) {
+	if !IsValidSecp256k1Scalar(s) {
+		return Uint256{}, errorf("must be a valid secp256k1 scalar")
+	}
+	if IsLowS(s) {
+		return s.Clone(), nil
+	}
+
+	i := Uint256{}
+	i.x.Sub(&secp256k1Order.x, &s.x)
+
+	return i, nil
+}
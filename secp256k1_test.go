@@ -0,0 +1,109 @@
+package bigutil_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+)
+
+const (
+	secp256k1N        = "0xfffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364141"
+	secp256k1NMinus1  = "0xfffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364140"
+	secp256k1HalfN    = "0x7fffffffffffffffffffffffffffffff5d576e7357a4501ddfe92f46681b20a0"
+	secp256k1HalfNUp1 = "0x7fffffffffffffffffffffffffffffff5d576e7357a4501ddfe92f46681b20a1"
+)
+
+func TestIsValidSecp256k1Scalar(t *testing.T) {
+	tcs := []struct {
+		name string
+		in   bigutil.Uint256
+		out  bool
+	}{
+		{
+			"zero",
+			bigutil.Uint64ToUint256(0),
+			false,
+		},
+		{
+			"one",
+			bigutil.Uint64ToUint256(1),
+			true,
+		},
+		{
+			"n - 1",
+			bigutil.MustHexToUint256(secp256k1NMinus1),
+			true,
+		},
+		{
+			"n",
+			bigutil.MustHexToUint256(secp256k1N),
+			false,
+		},
+		{
+			"max",
+			bigutil.MaxUint256(),
+			false,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.out, bigutil.IsValidSecp256k1Scalar(tc.in))
+		})
+	}
+}
+
+func TestIsLowS(t *testing.T) {
+	require.True(t, bigutil.IsLowS(bigutil.MustHexToUint256(secp256k1HalfN)))
+	require.False(t, bigutil.IsLowS(bigutil.MustHexToUint256(secp256k1HalfNUp1)))
+}
+
+func TestNormalizeS(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   bigutil.Uint256
+			out  bigutil.Uint256
+		}{
+			{
+				"low",
+				bigutil.Uint64ToUint256(1),
+				bigutil.Uint64ToUint256(1),
+			},
+			{
+				"half",
+				bigutil.MustHexToUint256(secp256k1HalfN),
+				bigutil.MustHexToUint256(secp256k1HalfN),
+			},
+			{
+				"high",
+				bigutil.MustHexToUint256(secp256k1NMinus1),
+				bigutil.Uint64ToUint256(1),
+			},
+			{
+				"half + 1",
+				bigutil.MustHexToUint256(secp256k1HalfNUp1),
+				bigutil.MustHexToUint256(secp256k1HalfN),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				s, err := bigutil.NormalizeS(tc.in)
+				require.Nil(t, err)
+
+				require.Zero(t, s.BigInt().Cmp(tc.out.BigInt()))
+			})
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		_, err := bigutil.NormalizeS(bigutil.Uint64ToUint256(0))
+		require.Error(t, err)
+
+		_, err = bigutil.NormalizeS(bigutil.MaxUint256())
+		require.Error(t, err)
+	})
+}
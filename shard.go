@@ -0,0 +1,62 @@
+package bigutil
+
+import (
+	"math/bits"
+)
+
+// ShardIndex returns x mod n, i.e. the index of the shard in [0, n) that x belongs to.
+// The result depends only on x and n and is stable across versions.
+// It panics if n is zero.
+func ShardIndex(x Uint256, n uint32) uint32 {
+	if n == 0 {
+		panic("n must be positive")
+	}
+
+	var r uint64
+	for _, w := range x.uint64s() {
+		r = bits.Rem64(r, w, uint64(n))
+	}
+
+	return uint32(r)
+}
+
+// JumpShardIndex returns the index of the shard in [0, n) that x belongs to,
+// using jump consistent hashing (Lamping and Veach, 2014) so that only about 1/n of the keys move when n grows.
+// The key of the hash is the XOR of the four 64-bit words of x.
+// The result depends only on x and n and is stable across versions.
+// It panics if n is zero.
+func JumpShardIndex(x Uint256, n uint32) uint32 {
+	if n == 0 {
+		panic("n must be positive")
+	}
+
+	var key uint64
+	for _, w := range x.uint64s() {
+		key ^= w
+	}
+
+	var b, j int64 = -1, 0
+	for j < int64(n) {
+		b = j
+		key = key*2862933555777941757 + 1
+		j = int64(float64(b+1) * (float64(int64(1)<<31) / float64((key>>33)+1)))
+	}
+
+	return uint32(b)
+}
+
+// MapToRange maps x into [0, n) as floor(x * n / 2^256), computed over 512 bits.
+// For a uniformly distributed x (e.g. a hash), each result occurs with probability within 1/2^256 of 1/n,
+// and unlike x mod n, the mapping preserves order.
+// It panics if n is zero.
+func MapToRange(x, n Uint256) Uint256 {
+	if n.x.Sign() == 0 {
+		panic("n must be positive")
+	}
+
+	i := Uint256{}
+	i.x.Mul(&x.x, &n.x)
+	i.x.Rsh(&i.x, maxBitLength)
+
+	return i
+}
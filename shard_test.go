@@ -0,0 +1,164 @@
+package bigutil_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+)
+
+func TestShardIndex(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			x    bigutil.Uint256
+			n    uint32
+		}{
+			{
+				"zero",
+				bigutil.Uint64ToUint256(0),
+				7,
+			},
+			{
+				"small",
+				bigutil.Uint64ToUint256(12345),
+				7,
+			},
+			{
+				"max",
+				bigutil.MaxUint256(),
+				4294967295,
+			},
+			{
+				"one shard",
+				bigutil.MaxUint256(),
+				1,
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				expected := new(big.Int).Mod(tc.x.BigInt(), big.NewInt(int64(tc.n)))
+
+				require.Equal(t, uint32(expected.Uint64()), bigutil.ShardIndex(tc.x, tc.n))
+			})
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		require.Panics(t, func() {
+			bigutil.ShardIndex(bigutil.Uint64ToUint256(1), 0)
+		})
+	})
+}
+
+func TestJumpShardIndex(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// The expected values pin the algorithm down so that it stays stable across versions.
+		tcs := []struct {
+			name string
+			x    bigutil.Uint256
+			n    uint32
+			out  uint32
+		}{
+			{
+				"one shard",
+				bigutil.MaxUint256(),
+				1,
+				0,
+			},
+			{
+				"zero",
+				bigutil.Uint64ToUint256(0),
+				10,
+				0,
+			},
+			{
+				"small",
+				bigutil.Uint64ToUint256(12345),
+				10,
+				1,
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Equal(t, tc.out, bigutil.JumpShardIndex(tc.x, tc.n))
+			})
+		}
+	})
+
+	t.Run("consistency", func(t *testing.T) {
+		moved := 0
+		for v := range uint64(1000) {
+			x := bigutil.MustBigIntToUint256(new(big.Int).Lsh(new(big.Int).SetUint64(v*2654435761), 100))
+
+			before, after := bigutil.JumpShardIndex(x, 10), bigutil.JumpShardIndex(x, 11)
+			require.Less(t, after, uint32(11))
+			if before != after {
+				require.Equal(t, uint32(10), after)
+				moved++
+			}
+		}
+
+		require.Less(t, moved, 200)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		require.Panics(t, func() {
+			bigutil.JumpShardIndex(bigutil.Uint64ToUint256(1), 0)
+		})
+	})
+}
+
+func TestMapToRange(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		half := bigutil.MustBigIntToUint256(new(big.Int).Lsh(big.NewInt(1), 255))
+
+		tcs := []struct {
+			name string
+			x    bigutil.Uint256
+			n    bigutil.Uint256
+			out  bigutil.Uint256
+		}{
+			{
+				"zero",
+				bigutil.Uint64ToUint256(0),
+				bigutil.Uint64ToUint256(10),
+				bigutil.Uint64ToUint256(0),
+			},
+			{
+				"half",
+				half,
+				bigutil.Uint64ToUint256(10),
+				bigutil.Uint64ToUint256(5),
+			},
+			{
+				"max",
+				bigutil.MaxUint256(),
+				bigutil.Uint64ToUint256(10),
+				bigutil.Uint64ToUint256(9),
+			},
+			{
+				"max range",
+				half,
+				bigutil.MaxUint256(),
+				bigutil.MustBigIntToUint256(new(big.Int).Sub(half.BigInt(), big.NewInt(1))),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Zero(t, bigutil.MapToRange(tc.x, tc.n).BigInt().Cmp(tc.out.BigInt()))
+			})
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		require.Panics(t, func() {
+			bigutil.MapToRange(bigutil.Uint64ToUint256(1), bigutil.Uint64ToUint256(0))
+		})
+	})
+}
@@ -0,0 +1,184 @@
+package bigutil
+
+import (
+	"math/big"
+	"slices"
+)
+
+// Compare returns -1 if a < b, 0 if a == b and +1 if a > b.
+// It is suitable for slices.SortFunc and the like.
+func Compare(a, b Uint256) int {
+	return a.Cmp(b)
+}
+
+// SortSlice sorts the given slice in ascending order.
+func SortSlice(s []Uint256) {
+	slices.SortFunc(s, Compare)
+}
+
+// SearchSlice searches for target in the given slice sorted in ascending order,
+// and returns the position where target is found, or the position where it would be inserted,
+// along with whether it is found.
+func SearchSlice(sorted []Uint256, target Uint256) (int, bool) {
+	return slices.BinarySearchFunc(sorted, target, Compare)
+}
+
+// IndexOf returns the index of the first occurrence of target in the given slice, or -1 if not present.
+func IndexOf(s []Uint256, target Uint256) int {
+	for idx := range s {
+		if s[idx].x.Cmp(&target.x) == 0 {
+			return idx
+		}
+	}
+
+	return -1
+}
+
+// Contains reports whether target is present in the given slice.
+func Contains(s []Uint256, target Uint256) bool {
+	return IndexOf(s, target) >= 0
+}
+
+// Dedup removes duplicate values from the given slice in place, keeping the first occurrences in order,
+// and returns the modified slice. The elements between the new length and the original length are zeroed.
+func Dedup(s []Uint256) []Uint256 {
+	seen := make(map[[maxByteLength]byte]struct{}, len(s))
+
+	n := 0
+	for idx := range s {
+		key := s[idx].bytes32()
+
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+
+		s[n] = s[idx]
+		n++
+	}
+
+	clear(s[n:])
+
+	return s[:n]
+}
+
+// MinOf returns the minimum value in the given slice.
+// It returns an error if the slice is empty.
+func MinOf(s []Uint256) (Uint256, error) {
+	if len(s) == 0 {
+		return Uint256{}, errorf("must not be empty")
+	}
+
+	return slices.MinFunc(s, Compare), nil
+}
+
+// MaxOf returns the maximum value in the given slice.
+// It returns an error if the slice is empty.
+func MaxOf(s []Uint256) (Uint256, error) {
+	if len(s) == 0 {
+		return Uint256{}, errorf("must not be empty")
+	}
+
+	return slices.MaxFunc(s, Compare), nil
+}
+
+// Min returns the minimum of the given values.
+// Unlike MinOf, it takes two or more values and thus cannot fail. It does not allocate.
+func Min(x, y Uint256, rest ...Uint256) Uint256 {
+	m := x
+	if y.Lt(m) {
+		m = y
+	}
+	for _, i := range rest {
+		if i.Lt(m) {
+			m = i
+		}
+	}
+
+	return m
+}
+
+// Max returns the maximum of the given values.
+// Unlike MaxOf, it takes two or more values and thus cannot fail. It does not allocate.
+func Max(x, y Uint256, rest ...Uint256) Uint256 {
+	m := x
+	if y.Gt(m) {
+		m = y
+	}
+	for _, i := range rest {
+		if i.Gt(m) {
+			m = i
+		}
+	}
+
+	return m
+}
+
+// Sum returns the sum of the values in the given slice; the sum of an empty slice is zero.
+// It returns an *IndexError for the value at which the total overflows.
+func Sum(s []Uint256) (Uint256, error) {
+	var a Accumulator
+	for idx, i := range s {
+		if err := a.Add(i); err != nil {
+			return Uint256{}, &IndexError{idx, err}
+		}
+	}
+
+	return a.Total(), nil
+}
+
+// SaturatingSum returns the sum of the values in the given slice, or MaxUint256 if the total overflows.
+func SaturatingSum(s []Uint256) Uint256 {
+	var a Accumulator
+	for _, i := range s {
+		if err := a.Add(i); err != nil {
+			return MaxUint256()
+		}
+	}
+
+	return a.Total()
+}
+
+// Mean returns the arithmetic mean of the values in the given slice, rounded with the given mode.
+// The sum is computed at full precision, so it never overflows.
+// It returns an error if the slice is empty or the rounding mode is invalid.
+func Mean(s []Uint256, rounding Rounding) (Uint256, error) {
+	if len(s) == 0 {
+		return Uint256{}, errorf("must not be empty")
+	}
+
+	var sum big.Int
+	for idx := range s {
+		sum.Add(&sum, &s[idx].x)
+	}
+
+	r := Uint256{}
+	if err := quoRound(&r.x, &sum, new(big.Int).SetInt64(int64(len(s))), rounding); err != nil {
+		return Uint256{}, err
+	}
+
+	return r, nil
+}
+
+// Median returns the median of the values in the given slice without modifying it.
+// For an even number of values, it returns the mean of the two middle ones, rounded with the given mode.
+// It returns an error if the slice is empty or the rounding mode is invalid.
+func Median(s []Uint256, rounding Rounding) (Uint256, error) {
+	if len(s) == 0 {
+		return Uint256{}, errorf("must not be empty")
+	}
+
+	if !rounding.valid() {
+		return Uint256{}, errorf("rounding must be valid")
+	}
+
+	sorted := slices.Clone(s)
+	SortSlice(sorted)
+
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return sorted[mid], nil
+	}
+
+	return Mean(sorted[mid-1:mid+1], rounding)
+}
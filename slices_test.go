@@ -0,0 +1,416 @@
+package bigutil_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+)
+
+func TestCompare(t *testing.T) {
+	tcs := []struct {
+		name string
+		a    bigutil.Uint256
+		b    bigutil.Uint256
+		out  int
+	}{
+		{
+			"less",
+			bigutil.Uint64ToUint256(1),
+			bigutil.MaxUint256(),
+			-1,
+		},
+		{
+			"equal",
+			bigutil.Uint256{},
+			bigutil.Uint64ToUint256(0),
+			0,
+		},
+		{
+			"greater",
+			bigutil.Uint64ToUint256(256),
+			bigutil.Uint64ToUint256(255),
+			1,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.out, bigutil.Compare(tc.a, tc.b))
+		})
+	}
+}
+
+func TestSortSlice(t *testing.T) {
+	s := uint256s(3, 1, 256, 0, 2)
+	bigutil.SortSlice(s)
+
+	requireUint256sEqual(t, uint256s(0, 1, 2, 3, 256), s)
+}
+
+func TestSearchSlice(t *testing.T) {
+	sorted := uint256s(1, 3, 5, 256)
+
+	tcs := []struct {
+		name  string
+		in    bigutil.Uint256
+		idx   int
+		found bool
+	}{
+		{
+			"first",
+			bigutil.Uint64ToUint256(1),
+			0,
+			true,
+		},
+		{
+			"last",
+			bigutil.Uint64ToUint256(256),
+			3,
+			true,
+		},
+		{
+			"missing in the middle",
+			bigutil.Uint64ToUint256(4),
+			2,
+			false,
+		},
+		{
+			"missing before the first",
+			bigutil.Uint64ToUint256(0),
+			0,
+			false,
+		},
+		{
+			"missing after the last",
+			bigutil.MaxUint256(),
+			4,
+			false,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			idx, found := bigutil.SearchSlice(sorted, tc.in)
+
+			require.Equal(t, tc.idx, idx)
+			require.Equal(t, tc.found, found)
+		})
+	}
+}
+
+func TestIndexOf(t *testing.T) {
+	s := uint256s(1, 3, 1, 256)
+
+	require.Equal(t, 0, bigutil.IndexOf(s, bigutil.Uint64ToUint256(1)))
+	require.Equal(t, 3, bigutil.IndexOf(s, bigutil.Uint64ToUint256(256)))
+	require.Equal(t, -1, bigutil.IndexOf(s, bigutil.Uint64ToUint256(2)))
+	require.Equal(t, -1, bigutil.IndexOf(nil, bigutil.Uint64ToUint256(0)))
+}
+
+func TestContains(t *testing.T) {
+	s := uint256s(1, 3, 1, 256)
+
+	require.True(t, bigutil.Contains(s, bigutil.MustHexToUint256("0x100")))
+	require.False(t, bigutil.Contains(s, bigutil.Uint64ToUint256(2)))
+}
+
+func TestDedup(t *testing.T) {
+	tcs := []struct {
+		name string
+		in   []bigutil.Uint256
+		out  []bigutil.Uint256
+	}{
+		{
+			"empty",
+			nil,
+			nil,
+		},
+		{
+			"no duplicates",
+			uint256s(3, 1, 2),
+			uint256s(3, 1, 2),
+		},
+		{
+			"duplicates",
+			uint256s(3, 1, 3, 0, 1, 256, 0),
+			uint256s(3, 1, 0, 256),
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			requireUint256sEqual(t, tc.out, bigutil.Dedup(tc.in))
+		})
+	}
+}
+
+func TestMinOf(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		i, err := bigutil.MinOf(uint256s(3, 1, 256, 2))
+		require.Nil(t, err)
+
+		require.Zero(t, i.BigInt().Cmp(bigutil.Uint64ToUint256(1).BigInt()))
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		_, err := bigutil.MinOf(nil)
+		require.Error(t, err)
+	})
+}
+
+func TestMaxOf(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		i, err := bigutil.MaxOf(uint256s(3, 1, 256, 2))
+		require.Nil(t, err)
+
+		require.Zero(t, i.BigInt().Cmp(bigutil.Uint64ToUint256(256).BigInt()))
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		_, err := bigutil.MaxOf(nil)
+		require.Error(t, err)
+	})
+}
+
+func TestMinMax(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			x    bigutil.Uint256
+			y    bigutil.Uint256
+			rest []bigutil.Uint256
+			min  bigutil.Uint256
+			max  bigutil.Uint256
+		}{
+			{
+				"two",
+				bigutil.Uint64ToUint256(2),
+				bigutil.One(),
+				nil,
+				bigutil.One(),
+				bigutil.Uint64ToUint256(2),
+			},
+			{
+				"equal",
+				bigutil.One(),
+				bigutil.One(),
+				nil,
+				bigutil.One(),
+				bigutil.One(),
+			},
+			{
+				"more",
+				bigutil.Uint64ToUint256(3),
+				bigutil.Uint64ToUint256(2),
+				[]bigutil.Uint256{bigutil.MaxUint256(), bigutil.Zero()},
+				bigutil.Zero(),
+				bigutil.MaxUint256(),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.True(t, bigutil.Min(tc.x, tc.y, tc.rest...).Equal(tc.min))
+				require.True(t, bigutil.Max(tc.x, tc.y, tc.rest...).Equal(tc.max))
+			})
+		}
+	})
+
+	t.Run("no allocation", func(t *testing.T) {
+		x, y, z := bigutil.MaxUint256(), bigutil.One(), bigutil.Zero()
+
+		require.Zero(t, testing.AllocsPerRun(100, func() {
+			_ = bigutil.Min(x, y, z)
+			_ = bigutil.Max(x, y, z)
+		}))
+	})
+}
+
+func TestSum(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   []bigutil.Uint256
+			out  bigutil.Uint256
+		}{
+			{
+				"empty",
+				nil,
+				bigutil.Zero(),
+			},
+			{
+				"small",
+				uint256s(1, 2, 3),
+				bigutil.Uint64ToUint256(6),
+			},
+			{
+				"max",
+				[]bigutil.Uint256{bigutil.MaxUint256(), bigutil.Zero()},
+				bigutil.MaxUint256(),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				out, err := bigutil.Sum(tc.in)
+				require.Nil(t, err)
+
+				require.Zero(t, out.BigInt().Cmp(tc.out.BigInt()))
+				require.Zero(t, bigutil.SaturatingSum(tc.in).BigInt().Cmp(tc.out.BigInt()))
+			})
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		in := []bigutil.Uint256{bigutil.One(), bigutil.MaxUint256(), bigutil.One()}
+
+		_, err := bigutil.Sum(in)
+
+		var indexErr *bigutil.IndexError
+		require.True(t, errors.As(err, &indexErr))
+		require.Equal(t, 1, indexErr.Index)
+
+		require.Zero(t, bigutil.SaturatingSum(in).BigInt().Cmp(bigutil.MaxUint256().BigInt()))
+	})
+}
+
+func TestMean(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name     string
+			in       []bigutil.Uint256
+			rounding bigutil.Rounding
+			out      bigutil.Uint256
+		}{
+			{
+				"exact",
+				uint256s(1, 2, 3),
+				bigutil.RoundFloor,
+				bigutil.Uint64ToUint256(2),
+			},
+			{
+				"floor",
+				uint256s(1, 2),
+				bigutil.RoundFloor,
+				bigutil.One(),
+			},
+			{
+				"ceil",
+				uint256s(1, 2),
+				bigutil.RoundCeil,
+				bigutil.Uint64ToUint256(2),
+			},
+			{
+				"full precision",
+				[]bigutil.Uint256{bigutil.MaxUint256(), bigutil.MaxUint256()},
+				bigutil.RoundFloor,
+				bigutil.MaxUint256(),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				out, err := bigutil.Mean(tc.in, tc.rounding)
+				require.Nil(t, err)
+
+				require.Zero(t, out.BigInt().Cmp(tc.out.BigInt()))
+			})
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		_, err := bigutil.Mean(nil, bigutil.RoundFloor)
+		require.Error(t, err)
+
+		_, err = bigutil.Mean(uint256s(1), bigutil.Rounding(-1))
+		require.Error(t, err)
+	})
+}
+
+func TestMedian(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name     string
+			in       []bigutil.Uint256
+			rounding bigutil.Rounding
+			out      bigutil.Uint256
+		}{
+			{
+				"odd",
+				uint256s(5, 1, 3),
+				bigutil.RoundFloor,
+				bigutil.Uint64ToUint256(3),
+			},
+			{
+				"even: floor",
+				uint256s(4, 1, 2, 100),
+				bigutil.RoundFloor,
+				bigutil.Uint64ToUint256(3),
+			},
+			{
+				"even: ceil",
+				uint256s(4, 1, 3, 100),
+				bigutil.RoundCeil,
+				bigutil.Uint64ToUint256(4),
+			},
+			{
+				"even: half even",
+				uint256s(4, 1, 3, 100),
+				bigutil.RoundHalfEven,
+				bigutil.Uint64ToUint256(4),
+			},
+			{
+				"even: max",
+				[]bigutil.Uint256{bigutil.MaxUint256(), bigutil.MaxUint256()},
+				bigutil.RoundCeil,
+				bigutil.MaxUint256(),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				in := slices.Clone(tc.in)
+
+				out, err := bigutil.Median(tc.in, tc.rounding)
+				require.Nil(t, err)
+
+				require.Zero(t, out.BigInt().Cmp(tc.out.BigInt()))
+
+				requireUint256sEqual(t, in, tc.in)
+			})
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		_, err := bigutil.Median(nil, bigutil.RoundFloor)
+		require.Error(t, err)
+
+		_, err = bigutil.Median(uint256s(1, 2), bigutil.Rounding(-1))
+		require.Error(t, err)
+
+		_, err = bigutil.Median(uint256s(1), bigutil.Rounding(99))
+		require.Error(t, err)
+	})
+}
+
+func uint256s(vs ...uint64) []bigutil.Uint256 {
+	is := make([]bigutil.Uint256, len(vs))
+	for idx, v := range vs {
+		is[idx] = bigutil.Uint64ToUint256(v)
+	}
+
+	return is
+}
+
+func requireUint256sEqual(t *testing.T, expected, actual []bigutil.Uint256) {
+	t.Helper()
+
+	require.Len(t, actual, len(expected))
+	for idx := range actual {
+		require.Zero(t, actual[idx].BigInt().Cmp(expected[idx].BigInt()), "index %d", idx)
+	}
+}
@@ -0,0 +1,29 @@
+package bigutil
+
+import "math/big"
+
+// bpsDenominator is the number of basis points in 100%.
+const bpsDenominator = 10_000
+
+// MinAmountOut returns the minimum output amount that tolerates the given slippage in basis points,
+// i.e. amount * (10000 - slippageBps) / 10000.
+// The result is rounded down, in the user's disfavor, so it never exceeds the exact bound.
+// It returns an error if slippageBps is greater than 10000.
+func MinAmountOut(amount Uint256, slippageBps uint64) (Uint256, error) {
+	if slippageBps > bpsDenominator {
+		return Uint256{}, errorf("slippage must be less than or equal to %d bps", bpsDenominator)
+	}
+
+	return MulDiv(amount, Uint64ToUint256(bpsDenominator-slippageBps), Uint64ToUint256(bpsDenominator), RoundFloor)
+}
+
+// MaxAmountIn returns the maximum input amount that tolerates the given slippage in basis points,
+// i.e. amount * (10000 + slippageBps) / 10000.
+// The result is rounded up, in the user's disfavor, so it is never below the exact bound.
+// It returns an error if the result overflows.
+func MaxAmountIn(amount Uint256, slippageBps uint64) (Uint256, error) {
+	m := Uint64ToUint256(bpsDenominator)
+	m.x.Add(&m.x, new(big.Int).SetUint64(slippageBps))
+
+	return MulDiv(amount, m, Uint64ToUint256(bpsDenominator), RoundCeil)
+}
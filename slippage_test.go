@@ -0,0 +1,110 @@
+package bigutil_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+)
+
+func TestMinAmountOut(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name        string
+			amount      bigutil.Uint256
+			slippageBps uint64
+			out         bigutil.Uint256
+		}{
+			{
+				"exact",
+				bigutil.Uint64ToUint256(10_000),
+				50,
+				bigutil.Uint64ToUint256(9_950),
+			},
+			{
+				"rounded down",
+				bigutil.Uint64ToUint256(999),
+				50,
+				bigutil.Uint64ToUint256(994),
+			},
+			{
+				"zero slippage",
+				bigutil.MaxUint256(),
+				0,
+				bigutil.MaxUint256(),
+			},
+			{
+				"full slippage",
+				bigutil.MaxUint256(),
+				10_000,
+				bigutil.Zero(),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				out, err := bigutil.MinAmountOut(tc.amount, tc.slippageBps)
+				require.Nil(t, err)
+
+				require.Zero(t, out.BigInt().Cmp(tc.out.BigInt()))
+			})
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		_, err := bigutil.MinAmountOut(bigutil.One(), 10_001)
+		require.Error(t, err)
+	})
+}
+
+func TestMaxAmountIn(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name        string
+			amount      bigutil.Uint256
+			slippageBps uint64
+			out         bigutil.Uint256
+		}{
+			{
+				"exact",
+				bigutil.Uint64ToUint256(10_000),
+				50,
+				bigutil.Uint64ToUint256(10_050),
+			},
+			{
+				"rounded up",
+				bigutil.Uint64ToUint256(999),
+				50,
+				bigutil.Uint64ToUint256(1_004),
+			},
+			{
+				"zero slippage",
+				bigutil.MaxUint256(),
+				0,
+				bigutil.MaxUint256(),
+			},
+			{
+				"large slippage",
+				bigutil.Uint64ToUint256(10_000),
+				math.MaxUint64,
+				bigutil.MustHexToUint256("0x1000000000000270f"),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				out, err := bigutil.MaxAmountIn(tc.amount, tc.slippageBps)
+				require.Nil(t, err)
+
+				require.Zero(t, out.BigInt().Cmp(tc.out.BigInt()))
+			})
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		_, err := bigutil.MaxAmountIn(bigutil.MaxUint256(), 1)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,49 @@
+package bigutil
+
+import (
+	"math/big"
+	"slices"
+)
+
+// Split divides x into parts proportional to the given weights,
+// distributing the remainder by the largest remainder method (ties go to the lower index),
+// so that the parts always sum to x.
+// It returns an error if weights is empty or all weights are zero.
+func Split(x Uint256, weights []Uint256) ([]Uint256, error) {
+	if len(weights) == 0 {
+		return nil, errorf("weights must not be empty")
+	}
+
+	total := new(big.Int)
+	for _, w := range weights {
+		total.Add(total, &w.x)
+	}
+	if total.Sign() == 0 {
+		return nil, errorf("total weight must be positive")
+	}
+
+	parts := make([]Uint256, len(weights))
+	rems := make([]big.Int, len(weights))
+
+	leftover := new(big.Int).Set(&x.x)
+	var prod big.Int
+	for idx, w := range weights {
+		prod.Mul(&x.x, &w.x)
+		parts[idx].x.QuoRem(&prod, total, &rems[idx])
+		leftover.Sub(leftover, &parts[idx].x)
+	}
+
+	// leftover is less than len(weights).
+	order := make([]int, len(weights))
+	for idx := range order {
+		order[idx] = idx
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		return rems[b].Cmp(&rems[a])
+	})
+	for _, idx := range order[:leftover.Uint64()] {
+		parts[idx].x.Add(&parts[idx].x, big.NewInt(1))
+	}
+
+	return parts, nil
+}
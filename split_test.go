@@ -0,0 +1,101 @@
+package bigutil_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+)
+
+func TestSplit(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name    string
+			x       bigutil.Uint256
+			weights []bigutil.Uint256
+			out     []bigutil.Uint256
+		}{
+			{
+				"exact",
+				bigutil.Uint64ToUint256(100),
+				uint256s(1, 3),
+				uint256s(25, 75),
+			},
+			{
+				"equal weights",
+				bigutil.Uint64ToUint256(100),
+				uint256s(1, 1, 1),
+				uint256s(34, 33, 33),
+			},
+			{
+				"largest remainder",
+				bigutil.Uint64ToUint256(10),
+				uint256s(1, 2, 4),
+				uint256s(1, 3, 6),
+			},
+			{
+				"zero weight",
+				bigutil.Uint64ToUint256(10),
+				uint256s(0, 1),
+				uint256s(0, 10),
+			},
+			{
+				"zero amount",
+				bigutil.Zero(),
+				uint256s(1, 2),
+				uint256s(0, 0),
+			},
+			{
+				"max",
+				bigutil.MaxUint256(),
+				[]bigutil.Uint256{
+					bigutil.MaxUint256(),
+					bigutil.MaxUint256(),
+				},
+				[]bigutil.Uint256{
+					bigutil.MustHexToUint256("0x8000000000000000000000000000000000000000000000000000000000000000"),
+					bigutil.MustHexToUint256("0x7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"),
+				},
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				parts, err := bigutil.Split(tc.x, tc.weights)
+				require.Nil(t, err)
+				requireUint256sEqual(t, tc.out, parts)
+
+				sum := new(big.Int)
+				for _, p := range parts {
+					sum.Add(sum, p.BigInt())
+				}
+				require.Zero(t, sum.Cmp(tc.x.BigInt()))
+			})
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name    string
+			weights []bigutil.Uint256
+		}{
+			{
+				"empty",
+				nil,
+			},
+			{
+				"all zero",
+				uint256s(0, 0),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := bigutil.Split(bigutil.One(), tc.weights)
+				require.Error(t, err)
+			})
+		}
+	})
+}
@@ -0,0 +1,31 @@
+package bigutil
+
+import (
+	"math/big"
+)
+
+// MappingSlot returns the storage slot of the value for the given key in a Solidity mapping at the given slot,
+// i.e. keccak256(key . slot) for a key of a value type.
+func MappingSlot(key, slot Uint256) Uint256 {
+	k, s := key.bytes32(), slot.bytes32()
+
+	return Keccak256ToUint256(k[:], s[:])
+}
+
+// ArrayElemSlot returns the storage slot of the element at the given index in a Solidity dynamic array at the given slot,
+// i.e. keccak256(slot) + index, wrapping around modulo 2^256.
+// It assumes that each element occupies exactly one slot.
+func ArrayElemSlot(slot, index Uint256) Uint256 {
+	s := slot.bytes32()
+	base := Keccak256ToUint256(s[:])
+
+	x := new(big.Int).Add(&base.x, &index.x)
+	if x.BitLen() > maxBitLength {
+		x.Sub(x, new(big.Int).Lsh(big.NewInt(1), maxBitLength))
+	}
+
+	i := Uint256{}
+	i.x = *x
+
+	return i
+}
@@ -0,0 +1,49 @@
+package bigutil_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+)
+
+func TestMappingSlot(t *testing.T) {
+	// keccak256 of 64 zero bytes
+	require.Equal(
+		t,
+		"0xad3228b676f7d3cd4284a5443f17f1962b36e491b30a40b2405849e597ba5fb5",
+		bigutil.MappingSlot(bigutil.Uint64ToUint256(0), bigutil.Uint64ToUint256(0)).String(),
+	)
+
+	require.NotEqual(
+		t,
+		bigutil.MappingSlot(bigutil.Uint64ToUint256(1), bigutil.Uint64ToUint256(0)).String(),
+		bigutil.MappingSlot(bigutil.Uint64ToUint256(0), bigutil.Uint64ToUint256(1)).String(),
+	)
+}
+
+func TestArrayElemSlot(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// keccak256(uint256(2))
+		base := "0x405787fa12a823e0f2b7631cc41b3ba8828b3321ca811111fa75cd3aa3bb5ace"
+
+		require.Equal(t, base, bigutil.ArrayElemSlot(bigutil.Uint64ToUint256(2), bigutil.Uint64ToUint256(0)).String())
+		require.Equal(
+			t,
+			"0x405787fa12a823e0f2b7631cc41b3ba8828b3321ca811111fa75cd3aa3bb5acf",
+			bigutil.ArrayElemSlot(bigutil.Uint64ToUint256(2), bigutil.Uint64ToUint256(1)).String(),
+		)
+	})
+
+	t.Run("wrap around", func(t *testing.T) {
+		slot := bigutil.Uint64ToUint256(2)
+		base := bigutil.ArrayElemSlot(slot, bigutil.Uint64ToUint256(0))
+
+		// 2^256 - base wraps around to zero
+		index := bigutil.MustHexToUint256("0xbfa87805ed57dc1f0d489ce33be4c4577d74ccde357eeeee058a32c55c44a532")
+
+		require.Equal(t, "0x0", bigutil.ArrayElemSlot(slot, index).String())
+		require.Equal(t, "0x405787fa12a823e0f2b7631cc41b3ba8828b3321ca811111fa75cd3aa3bb5ace", base.String())
+	})
+}
@@ -0,0 +1,76 @@
+package bigutil
+
+import (
+	"errors"
+	"io"
+)
+
+// maxHexStreamLength is the maximum length of a hex string read by DecodeHexStream: the prefix and 64 digits.
+const maxHexStreamLength = 2 + maxByteLength*2
+
+// DecodeHexStream reads a 0x-prefixed hex string from r and converts it to Uint256.
+// The string ends at EOF or at the first ASCII whitespace character, which is consumed.
+// At most 64 digits (including leading zero digits) are accepted, so at most 67 bytes are read from r;
+// bytes are read one at a time so that r is not read beyond the string.
+func DecodeHexStream(r io.Reader) (Uint256, error) {
+	br, ok := r.(io.ByteReader)
+	if !ok {
+		br = byteReader{r}
+	}
+
+	var buf [maxHexStreamLength]byte
+	n := 0
+	for {
+		c, err := br.ReadByte()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return Uint256{}, err
+		}
+		if isSpace(c) {
+			break
+		}
+		if n == len(buf) {
+			return Uint256{}, newParseError(buf[:], -1, ReasonOutOfRange)
+		}
+
+		buf[n] = c
+		n++
+	}
+
+	b, err := decodeHexBytes(buf[:n], true)
+	if err != nil {
+		return Uint256{}, err
+	}
+
+	i := Uint256{}
+	i.x.SetBytes(b[:])
+
+	return i, nil
+}
+
+// EncodeHexStream writes the 0x-prefixed hex string of x to w.
+func EncodeHexStream(w io.Writer, x Uint256) error {
+	_, err := io.WriteString(w, x.string())
+
+	return err
+}
+
+// byteReader reads one byte at a time from an io.Reader that does not implement io.ByteReader.
+type byteReader struct {
+	r io.Reader
+}
+
+func (br byteReader) ReadByte() (byte, error) {
+	var b [1]byte
+	if _, err := io.ReadFull(br.r, b[:]); err != nil {
+		return 0, err
+	}
+
+	return b[0], nil
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\v' || c == '\f'
+}
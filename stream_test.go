@@ -0,0 +1,119 @@
+package bigutil_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+)
+
+func TestDecodeHexStream(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   string
+			out  bigutil.Uint256
+			rest string
+		}{
+			{
+				"eof",
+				"0xff",
+				bigutil.Uint64ToUint256(255),
+				"",
+			},
+			{
+				"whitespace",
+				"0xff\n0x1",
+				bigutil.Uint64ToUint256(255),
+				"0x1",
+			},
+			{
+				"max with leading zero digits",
+				"0x" + strings.Repeat("f", 64) + " ",
+				bigutil.MaxUint256(),
+				"",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				// A reader that does not implement io.ByteReader.
+				r := iotest.OneByteReader(strings.NewReader(tc.in))
+
+				i, err := bigutil.DecodeHexStream(r)
+				require.Nil(t, err)
+				require.Zero(t, i.BigInt().Cmp(tc.out.BigInt()))
+
+				rest, err := io.ReadAll(r)
+				require.Nil(t, err)
+				require.Equal(t, tc.rest, string(rest))
+			})
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name   string
+			in     string
+			reason bigutil.ParseErrorReason
+		}{
+			{
+				"empty",
+				"",
+				bigutil.ReasonMissingPrefix,
+			},
+			{
+				"invalid digit",
+				"0xfg",
+				bigutil.ReasonInvalidDigit,
+			},
+			{
+				"too long",
+				"0x0" + strings.Repeat("f", 64),
+				bigutil.ReasonOutOfRange,
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := bigutil.DecodeHexStream(strings.NewReader(tc.in))
+
+				var parseErr *bigutil.ParseError
+				require.True(t, errors.As(err, &parseErr))
+				require.Equal(t, tc.reason, parseErr.Reason)
+			})
+		}
+
+		t.Run("bounded read", func(t *testing.T) {
+			r := strings.NewReader("0x" + strings.Repeat("0", 1000))
+
+			_, err := bigutil.DecodeHexStream(r)
+			require.Error(t, err)
+			require.Equal(t, 1002-67, r.Len())
+		})
+
+		t.Run("read error", func(t *testing.T) {
+			errRead := errors.New("read")
+
+			_, err := bigutil.DecodeHexStream(iotest.ErrReader(errRead))
+			require.ErrorIs(t, err, errRead)
+		})
+	})
+}
+
+func TestEncodeHexStream(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.Nil(t, bigutil.EncodeHexStream(&buf, bigutil.Uint64ToUint256(255)))
+
+		i, err := bigutil.DecodeHexStream(&buf)
+		require.Nil(t, err)
+		require.True(t, i.EqualUint64(255))
+	})
+}
@@ -1,11 +1,14 @@
 package bigutil
 
 import (
+	"database/sql"
 	"database/sql/driver"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"hash/maphash"
 	"math/big"
-
-	ethhexutil "github.com/ethereum/go-ethereum/common/hexutil"
-	"github.com/samber/oops"
+	"reflect"
 )
 
 const (
@@ -13,11 +16,36 @@ const (
 	maxBitLength  = maxByteLength * 8
 )
 
+var maxBig256 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), maxBitLength), big.NewInt(1))
+
 // Uint256 is a wrapper for big.Int that represents uint256.
+//
+// Like big.Int, a Uint256 obtained by plain assignment shares storage with the original.
+// This is harmless as long as neither is modified in place (e.g. with the Set methods);
+// use Clone or Copy to get an independent value.
 type Uint256 struct {
 	x big.Int
 }
 
+// Zero returns the Uint256 of value 0.
+func Zero() Uint256 {
+	return Uint256{}
+}
+
+// One returns the Uint256 of value 1.
+func One() Uint256 {
+	return Uint64ToUint256(1)
+}
+
+// MaxUint256 returns the Uint256 of value 2^256 - 1.
+// Each call returns a new value that does not share storage with the others.
+func MaxUint256() Uint256 {
+	i := Uint256{}
+	i.x.Set(maxBig256)
+
+	return i
+}
+
 // Uint64ToUint256 converts the given uint64 to Uint256.
 func Uint64ToUint256(i uint64) Uint256 {
 	return MustBigIntToUint256(new(big.Int).SetUint64(i))
@@ -25,7 +53,7 @@ func Uint64ToUint256(i uint64) Uint256 {
 
 // HexToUint256 converts the given hex string to Uint256.
 func HexToUint256(s string) (Uint256, error) {
-	x, err := ethhexutil.DecodeBig(s)
+	x, err := decodeHex(s, false)
 	if err != nil {
 		return Uint256{}, err
 	}
@@ -45,10 +73,11 @@ func MustHexToUint256(s string) Uint256 {
 }
 
 // BigIntToUint256 converts the given big.Int to Uint256.
+// The returned Uint256 does not share storage with x.
 func BigIntToUint256(x *big.Int) (Uint256, error) {
 	i := Uint256{}
 
-	if err := i.setBigInt(x); err != nil {
+	if err := i.SetBigInt(x); err != nil {
 		return Uint256{}, err
 	}
 
@@ -66,113 +95,314 @@ func MustBigIntToUint256(x *big.Int) Uint256 {
 	return i
 }
 
-// BigInt returns the big.Int.
+// Copy sets dst to the value of src.
+// dst does not share storage with src afterwards, even if it did before.
+func Copy(dst *Uint256, src Uint256) {
+	// dst may share storage with src, e.g. if obtained from it by plain assignment, so it is detached first.
+	dst.x.SetBits(nil)
+	dst.x.Set(&src.x)
+}
+
+// Clone returns a copy of i that does not share storage with i.
+func (i Uint256) Clone() Uint256 {
+	c := Uint256{}
+	c.x.Set(&i.x)
+
+	return c
+}
+
+// BigInt returns a copy of the big.Int.
 func (i Uint256) BigInt() *big.Int {
+	return new(big.Int).Set(&i.x)
+}
+
+// BigIntUnsafe returns the underlying big.Int without copying it.
+// The returned big.Int must be treated as read-only; modifying it modifies i.
+func (i *Uint256) BigIntUnsafe() *big.Int {
 	return &i.x
 }
 
+// IsZero reports whether i is zero.
+func (i Uint256) IsZero() bool {
+	return i.x.Sign() == 0
+}
+
+// Sign returns 0 if i is zero and +1 otherwise.
+func (i Uint256) Sign() int {
+	return i.x.Sign()
+}
+
+// BitLen returns the length of i in bits; the bit length of zero is 0.
+func (i Uint256) BitLen() int {
+	return i.x.BitLen()
+}
+
+// SetBigInt sets i to the given big.Int, reusing the storage of i.
+// i is left unchanged if x does not represent uint256.
+//
+// A Uint256 obtained by plain assignment shares storage with the original,
+// so the Set methods modify both unless one of them was cloned.
+func (i *Uint256) SetBigInt(x *big.Int) error {
+	if err := Validate(x); err != nil {
+		return err
+	}
+
+	i.x.Set(x)
+
+	return nil
+}
+
+// SetHex sets i to the value of the given hex string, reusing the storage of i.
+// i is left unchanged for invalid input.
+func (i *Uint256) SetHex(s string) error {
+	b, err := decodeHexBytes(s, false)
+	if err != nil {
+		return err
+	}
+
+	i.x.SetBytes(b[:])
+
+	return nil
+}
+
+// SetUint64 sets i to the given uint64, reusing the storage of i.
+func (i *Uint256) SetUint64(v uint64) {
+	i.x.SetUint64(v)
+}
+
+// SetBytes sets i to the value of the given big-endian bytes, reusing the storage of i.
+// i is left unchanged if b is longer than 32 bytes.
+func (i *Uint256) SetBytes(b []byte) error {
+	if len(b) > maxByteLength {
+		return errorf("must be less than or equal to %d bytes", maxByteLength)
+	}
+
+	i.x.SetBytes(b)
+
+	return nil
+}
+
+// Wipe overwrites the storage of i with zeros and sets i to zero.
+// Copies made beforehand (e.g. by BigInt or Clone) are not affected.
+func (i *Uint256) Wipe() {
+	words := i.x.Bits()
+	clear(words[:cap(words)])
+
+	i.x.SetBits(words[:0])
+}
+
+// Hash returns the hash of i with the given seed, consistent with maphash.
+// Equal values have equal hashes. It does not allocate.
+func (i Uint256) Hash(seed maphash.Seed) uint64 {
+	b := i.bytes32()
+
+	return maphash.Bytes(seed, b[:])
+}
+
 // String implements the fmt.Stringer interface.
 func (i Uint256) String() string {
 	return i.string()
 }
 
 // Value implements the driver.Valuer interface.
+// It returns the minimal big-endian bytes, or exactly 32 bytes if StrictScan is true.
 func (i Uint256) Value() (driver.Value, error) {
+	return i.value(StrictScan), nil
+}
+
+// Scan implements the sql.Scanner interface.
+// If StrictScan is true, src must be exactly 32 bytes.
+// A SQL NULL is decoded according to SQLNull.
+func (i *Uint256) Scan(src any) error {
+	return i.scan(src, StrictScan, SQLNull)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (i Uint256) MarshalText() ([]byte, error) {
+	return []byte(i.string()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// If StrictParsing is true, only canonical forms are accepted.
+// Only the formats allowed by AcceptedFormats are accepted.
+func (i *Uint256) UnmarshalText(text []byte) error {
+	return i.unmarshalText(text, DefaultCodec())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// A JSON null is decoded according to JSONNull.
+func (i *Uint256) UnmarshalJSON(b []byte) error {
+	return i.unmarshalJSON(b, DefaultCodec())
+}
+
+// UnmarshalParam implements the BindUnmarshaler interfaces of gin and echo,
+// so that path, query, form and header parameters bind directly into Uint256 fields.
+// It accepts the same forms as UnmarshalText; the returned error is reported by the frameworks as 400 Bad Request.
+func (i *Uint256) UnmarshalParam(param string) error {
+	return i.UnmarshalText([]byte(param))
+}
+
+// bytes32 returns the big-endian 32-byte representation of i.
+func (i *Uint256) bytes32() [maxByteLength]byte {
+	var b [maxByteLength]byte
+	i.x.FillBytes(b[:])
+
+	return b
+}
+
+// uint64s returns the 64-bit words of i, most significant first.
+func (i *Uint256) uint64s() [4]uint64 {
+	b := i.bytes32()
+
+	var ws [4]uint64
+	for idx := range ws {
+		ws[idx] = binary.BigEndian.Uint64(b[idx*8:])
+	}
+
+	return ws
+}
+
+// value returns the big-endian bytes of i, which are minimal unless fixed is true.
+func (i Uint256) value(fixed bool) []byte {
+	if fixed {
+		b := i.bytes32()
+		return b[:]
+	}
+
 	b := i.x.Bytes()
 	if len(b) == 0 {
 		b = []byte{0x0}
 	}
 
-	return b, nil
+	return b
 }
 
-// Scan implements the sql.Scanner interface.
-func (i *Uint256) Scan(src any) error {
+func (i Uint256) string() string {
+	return encodeHex(&i.x)
+}
+
+func (i *Uint256) scan(src any, strict bool, null NullBehavior) error {
 	if src == nil {
-		return oops.Errorf("src must not be nil")
+		if i.setNull(null) {
+			return nil
+		}
+
+		return errorf("src must not be nil")
 	}
 
-	b, ok := src.([]byte)
+	b, ok := srcBytes(src)
 	if !ok {
-		return oops.Errorf("unexpected src type: %T", src)
+		return errorf("unexpected src type: %T%s", src, inputSuffix(truncateInput(fmt.Sprint(src))))
 	}
 	if len(b) == 0 {
-		return oops.Errorf("src must not be empty")
+		return errorf("src must not be empty")
 	}
 	if len(b) > maxByteLength {
-		return oops.Errorf("src must be less than or equal to %d bytes", maxByteLength)
+		return errorf("src must be less than or equal to %d bytes%s", maxByteLength, inputSuffix(truncateInput(hex.EncodeToString(b))))
+	}
+	if strict && len(b) != maxByteLength {
+		return errorf("src must be %d bytes%s", maxByteLength, inputSuffix(truncateInput(hex.EncodeToString(b))))
 	}
 
+	// SetBytes copies b, which drivers may reuse (e.g. sql.RawBytes).
 	i.x.SetBytes(b)
 
 	return nil
 }
 
-// MarshalText implements the encoding.TextMarshaler interface.
-func (i Uint256) MarshalText() ([]byte, error) {
-	return []byte(i.string()), nil
+// srcBytes returns the bytes of the given Scan source
+// if it is []byte or a named type of it, such as sql.RawBytes.
+func srcBytes(src any) ([]byte, bool) {
+	switch v := src.(type) {
+	case []byte:
+		return v, true
+	case sql.RawBytes:
+		return v, true
+	}
+
+	rv := reflect.ValueOf(src)
+	if rv.Kind() == reflect.Slice && rv.Type().Elem().Kind() == reflect.Uint8 {
+		return rv.Bytes(), true
+	}
+
+	return nil, false
 }
 
-// UnmarshalText implements the encoding.TextUnmarshaler interface.
-func (i *Uint256) UnmarshalText(text []byte) error {
-	x := new(big.Int)
-	{
-		var err error
-
-		if l := len(text); l >= 2 && text[0] == '0' && text[1] == 'x' {
-			if l == 2 {
-				return oops.Errorf("must not be empty")
-			}
-
-			var textWithoutLeadingZeroDigits []byte
-			{
-				for idx, c := range text[2:] {
-					if c == '0' {
-						continue
-					}
-
-					textWithoutLeadingZeroDigits = append([]byte{'0', 'x'}, text[2+idx:]...)
-
-					break
-				}
-
-				if len(textWithoutLeadingZeroDigits) == 0 {
-					textWithoutLeadingZeroDigits = []byte{'0', 'x', '0'}
-				}
-			}
-
-			if x, err = ethhexutil.DecodeBig(string(textWithoutLeadingZeroDigits)); err != nil {
-				return err
-			}
-		} else {
-			if err := x.UnmarshalText(text); err != nil {
-				return err
-			}
+func (i *Uint256) unmarshalText(text []byte, c Codec) error {
+	if err := checkAccepted(text, c.AcceptedFormats); err != nil {
+		return err
+	}
+	if c.StrictParsing {
+		return i.unmarshalTextStrict(text)
+	}
+
+	if isHexText(text) {
+		x, err := decodeHex(text, true)
+		if err != nil {
+			return err
+		}
+
+		return i.setBigInt(x)
+	}
+
+	if err := validateDecimal(text); err != nil {
+		if len(text) > 0 && text[0] == '-' && validateDecimal(text[1:]) == nil {
+			return newParseError(text, 0, ReasonNegative)
 		}
+
+		return err
 	}
 
+	x, _ := new(big.Int).SetString(string(text), 10)
+
 	return i.setBigInt(x)
 }
 
-// UnmarshalJSON implements the json.Unmarshaler interface.
-func (i *Uint256) UnmarshalJSON(b []byte) error {
-	if b[0] == '"' && b[len(b)-1] == '"' {
-		b = b[1 : len(b)-1]
+func (i *Uint256) unmarshalJSON(b []byte, c Codec) error {
+	if string(b) == "null" && i.setNull(c.JSONNull) {
+		return nil
 	}
 
-	return i.UnmarshalText(b)
+	b, _ = unquote(b)
+
+	return i.unmarshalText(b, c)
 }
 
-func (i Uint256) string() string {
-	return ethhexutil.EncodeBig(&i.x)
+// setNull decodes a null input according to null, and reports whether it was accepted.
+func (i *Uint256) setNull(null NullBehavior) bool {
+	switch null {
+	case NullAsZero:
+		i.x.SetUint64(0)
+		return true
+	case NullAsUnchanged:
+		return true
+	default:
+		return false
+	}
 }
 
-func (i *Uint256) setBigInt(x *big.Int) error {
-	if x.Sign() < 0 {
-		return oops.Errorf("must be positive")
+func (i *Uint256) unmarshalTextStrict(text []byte) error {
+	if len(text) >= 2 && text[0] == '0' && text[1] == 'x' {
+		x, err := decodeHex(text, false)
+		if err != nil {
+			return err
+		}
+
+		return i.setBigInt(x)
 	}
-	if x.BitLen() > maxBitLength {
-		return oops.Errorf("must be less than or equal to %d bits", maxBitLength)
+
+	if err := validateDecimal(text); err != nil {
+		return err
+	}
+
+	x, _ := new(big.Int).SetString(string(text), 10)
+
+	return i.setBigInt(x)
+}
+
+func (i *Uint256) setBigInt(x *big.Int) error {
+	if err := Validate(x); err != nil {
+		return err
 	}
 
 	i.x = *x
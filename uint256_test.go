@@ -1,16 +1,346 @@
 package bigutil_test
 
 import (
+	"database/sql"
 	"database/sql/driver"
 	"encoding/json"
+	"hash/maphash"
+	"math/big"
 	"testing"
 
-	ethmath "github.com/ethereum/go-ethereum/common/math"
 	"github.com/stretchr/testify/require"
 
 	"github.com/m0t0k1ch1-go/bigutil/v2"
 )
 
+func TestConstants(t *testing.T) {
+	// 2^256 - 1, built independently of the value under test.
+	maxBig256 := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+	t.Run("success", func(t *testing.T) {
+		require.Zero(t, bigutil.Zero().BigInt().Sign())
+		require.Zero(t, bigutil.One().BigInt().Cmp(big.NewInt(1)))
+		require.Zero(t, bigutil.MaxUint256().BigInt().Cmp(maxBig256))
+	})
+
+	t.Run("independent", func(t *testing.T) {
+		i := bigutil.MaxUint256()
+		i.SetUint64(0)
+
+		require.Zero(t, bigutil.MaxUint256().BigInt().Cmp(maxBig256))
+	})
+}
+
+func TestHexToUint256(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   string
+			out  bigutil.Uint256
+		}{
+			{
+				"min",
+				"0x0",
+				bigutil.Uint64ToUint256(0),
+			},
+			{
+				"one",
+				"0x1",
+				bigutil.Uint64ToUint256(1),
+			},
+			{
+				"upper case",
+				"0XABCDEF",
+				bigutil.Uint64ToUint256(0xabcdef),
+			},
+			{
+				"max",
+				"0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+				bigutil.MaxUint256(),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				i, err := bigutil.HexToUint256(tc.in)
+				require.Nil(t, err)
+
+				require.Zero(t, i.BigInt().Cmp(tc.out.BigInt()))
+			})
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   string
+		}{
+			{
+				"empty",
+				"",
+			},
+			{
+				"without prefix",
+				"ff",
+			},
+			{
+				"prefix only",
+				"0x",
+			},
+			{
+				"leading zero digits",
+				"0x01",
+			},
+			{
+				"invalid digit",
+				"0xfg",
+			},
+			{
+				"too large",
+				"0x10000000000000000000000000000000000000000000000000000000000000000",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := bigutil.HexToUint256(tc.in)
+				require.Error(t, err)
+			})
+		}
+	})
+}
+
+func TestBigIntToUint256(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		x := big.NewInt(1)
+
+		i, err := bigutil.BigIntToUint256(x)
+		require.Nil(t, err)
+
+		x.SetUint64(2)
+
+		require.Zero(t, i.BigInt().Cmp(big.NewInt(1)))
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		_, err := bigutil.BigIntToUint256(big.NewInt(-1))
+		require.Error(t, err)
+
+		_, err = bigutil.BigIntToUint256(new(big.Int).Lsh(big.NewInt(1), 256))
+		require.Error(t, err)
+	})
+}
+
+func TestCopy(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		src := bigutil.Uint64ToUint256(1)
+
+		var dst bigutil.Uint256
+		bigutil.Copy(&dst, src)
+		dst.SetUint64(2)
+
+		require.Zero(t, src.BigInt().Cmp(big.NewInt(1)))
+		require.Zero(t, dst.BigInt().Cmp(big.NewInt(2)))
+	})
+}
+
+func TestUint256Clone(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		i := bigutil.Uint64ToUint256(1)
+
+		c := i.Clone()
+		c.SetUint64(2)
+
+		require.Zero(t, i.BigInt().Cmp(big.NewInt(1)))
+		require.Zero(t, c.BigInt().Cmp(big.NewInt(2)))
+	})
+}
+
+func TestUint256Assignment(t *testing.T) {
+	t.Run("shares storage", func(t *testing.T) {
+		i := bigutil.Uint64ToUint256(1)
+
+		j := i
+		j.SetUint64(2)
+
+		require.Zero(t, i.BigInt().Cmp(big.NewInt(2)))
+	})
+
+	t.Run("copy detaches shared storage", func(t *testing.T) {
+		i := bigutil.Uint64ToUint256(1)
+
+		j := i
+		bigutil.Copy(&j, i)
+		j.SetUint64(2)
+
+		require.Zero(t, i.BigInt().Cmp(big.NewInt(1)))
+		require.Zero(t, j.BigInt().Cmp(big.NewInt(2)))
+	})
+
+	t.Run("replacing values does not affect copies", func(t *testing.T) {
+		i := bigutil.Uint64ToUint256(1)
+
+		j := i
+		j = bigutil.Uint64ToUint256(2)
+
+		require.Zero(t, i.BigInt().Cmp(big.NewInt(1)))
+		require.Zero(t, j.BigInt().Cmp(big.NewInt(2)))
+	})
+}
+
+func TestUint256BigInt(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		i := bigutil.Uint64ToUint256(1)
+
+		x := i.BigInt()
+		x.SetUint64(2)
+
+		require.Zero(t, i.BigInt().Cmp(big.NewInt(1)))
+	})
+}
+
+func TestUint256BigIntUnsafe(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		i := bigutil.Uint64ToUint256(1)
+
+		require.Zero(t, i.BigIntUnsafe().Cmp(big.NewInt(1)))
+		require.Same(t, i.BigIntUnsafe(), i.BigIntUnsafe())
+	})
+}
+
+func TestUint256IsZero(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name   string
+			in     bigutil.Uint256
+			isZero bool
+			sign   int
+			bitLen int
+		}{
+			{
+				"zero value",
+				bigutil.Uint256{},
+				true,
+				0,
+				0,
+			},
+			{
+				"one",
+				bigutil.One(),
+				false,
+				1,
+				1,
+			},
+			{
+				"max",
+				bigutil.MaxUint256(),
+				false,
+				1,
+				256,
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Equal(t, tc.isZero, tc.in.IsZero())
+				require.Equal(t, tc.sign, tc.in.Sign())
+				require.Equal(t, tc.bitLen, tc.in.BitLen())
+			})
+		}
+	})
+}
+
+func TestUint256SetBigInt(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		var i bigutil.Uint256
+		require.Nil(t, i.SetBigInt(bigutil.MaxUint256().BigInt()))
+
+		require.Zero(t, i.BigInt().Cmp(bigutil.MaxUint256().BigInt()))
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		i := bigutil.Uint64ToUint256(1)
+		require.Error(t, i.SetBigInt(big.NewInt(-1)))
+		require.Error(t, i.SetBigInt(new(big.Int).Lsh(big.NewInt(1), 256)))
+
+		require.Zero(t, i.BigInt().Cmp(big.NewInt(1)))
+	})
+}
+
+func TestUint256SetHex(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		var i bigutil.Uint256
+		require.Nil(t, i.SetHex("0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"))
+
+		require.Zero(t, i.BigInt().Cmp(bigutil.MaxUint256().BigInt()))
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		i := bigutil.Uint64ToUint256(1)
+		require.Error(t, i.SetHex("0xg"))
+
+		require.Zero(t, i.BigInt().Cmp(big.NewInt(1)))
+	})
+}
+
+func TestUint256SetUint64(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		i := bigutil.MaxUint256()
+		i.SetUint64(1)
+
+		require.Zero(t, i.BigInt().Cmp(big.NewInt(1)))
+	})
+}
+
+func TestUint256SetBytes(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		var i bigutil.Uint256
+		require.Nil(t, i.SetBytes([]byte{0x1, 0x0}))
+
+		require.Zero(t, i.BigInt().Cmp(big.NewInt(256)))
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		i := bigutil.Uint64ToUint256(1)
+		require.Error(t, i.SetBytes(make([]byte, 33)))
+
+		require.Zero(t, i.BigInt().Cmp(big.NewInt(1)))
+	})
+}
+
+func TestUint256Wipe(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		i := bigutil.MaxUint256()
+		words := i.BigIntUnsafe().Bits()
+
+		i.Wipe()
+
+		require.Zero(t, i.BigInt().Sign())
+		for _, w := range words {
+			require.Zero(t, w)
+		}
+	})
+}
+
+func TestUint256Hash(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		seed := maphash.MakeSeed()
+
+		require.Equal(t, bigutil.Uint256{}.Hash(seed), bigutil.Uint64ToUint256(0).Hash(seed))
+		require.Equal(t, bigutil.Uint64ToUint256(255).Hash(seed), bigutil.MustHexToUint256("0xff").Hash(seed))
+		require.NotEqual(t, bigutil.Uint64ToUint256(1).Hash(seed), bigutil.Uint64ToUint256(2).Hash(seed))
+	})
+
+	t.Run("no allocation", func(t *testing.T) {
+		seed := maphash.MakeSeed()
+		i := bigutil.MaxUint256()
+
+		require.Zero(t, testing.AllocsPerRun(100, func() {
+			i.Hash(seed)
+		}))
+	})
+}
+
 func TestUint256Value(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tcs := []struct {
@@ -30,7 +360,7 @@ func TestUint256Value(t *testing.T) {
 			},
 			{
 				"max",
-				bigutil.MustBigIntToUint256(ethmath.MaxBig256),
+				bigutil.MaxUint256(),
 				[]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
 			},
 		}
@@ -61,7 +391,17 @@ func TestUint256Scan(t *testing.T) {
 			{
 				"max",
 				[]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
-				bigutil.MustBigIntToUint256(ethmath.MaxBig256),
+				bigutil.MaxUint256(),
+			},
+			{
+				"sql.RawBytes",
+				sql.RawBytes{0x1},
+				bigutil.Uint64ToUint256(1),
+			},
+			{
+				"named []byte",
+				namedBytes{0x1},
+				bigutil.Uint64ToUint256(1),
 			},
 		}
 
@@ -73,9 +413,58 @@ func TestUint256Scan(t *testing.T) {
 				require.Zero(t, i.BigInt().Cmp(tc.out.BigInt()))
 			})
 		}
+
+		t.Run("reused buffer", func(t *testing.T) {
+			buf := sql.RawBytes{0x1}
+
+			var i bigutil.Uint256
+			require.Nil(t, i.Scan(buf))
+			buf[0] = 0x2
+
+			require.True(t, i.EqualUint64(1))
+		})
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   any
+		}{
+			{
+				"nil",
+				nil,
+			},
+			{
+				"string",
+				"0x1",
+			},
+			{
+				"empty",
+				[]byte{},
+			},
+			{
+				"too long",
+				make([]byte, 33),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var i bigutil.Uint256
+				require.Error(t, i.Scan(tc.in))
+			})
+		}
+
+		t.Run("input in message", func(t *testing.T) {
+			var i bigutil.Uint256
+			require.ErrorContains(t, i.Scan("0x1"), `"0x1"`)
+			require.ErrorContains(t, i.Scan(append(make([]byte, 32), 0xab)), "ab")
+		})
 	})
 }
 
+type namedBytes []byte
+
 func TestUint256MarshalJSON(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tcs := []struct {
@@ -95,7 +484,7 @@ func TestUint256MarshalJSON(t *testing.T) {
 			},
 			{
 				"max",
-				bigutil.MustBigIntToUint256(ethmath.MaxBig256),
+				bigutil.MaxUint256(),
 				[]byte(`"0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"`),
 			},
 		}
@@ -141,7 +530,7 @@ func TestUint256UnmarshalJSON(t *testing.T) {
 			{
 				"max (hexadecimal string)",
 				[]byte(`"0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"`),
-				bigutil.MustBigIntToUint256(ethmath.MaxBig256),
+				bigutil.MaxUint256(),
 			},
 			{
 				"min (decimal string)",
@@ -151,7 +540,7 @@ func TestUint256UnmarshalJSON(t *testing.T) {
 			{
 				"max (decimal string)",
 				[]byte(`"115792089237316195423570985008687907853269984665640564039457584007913129639935"`),
-				bigutil.MustBigIntToUint256(ethmath.MaxBig256),
+				bigutil.MaxUint256(),
 			},
 			{
 				"min (number)",
@@ -161,7 +550,7 @@ func TestUint256UnmarshalJSON(t *testing.T) {
 			{
 				"max (number)",
 				[]byte(`115792089237316195423570985008687907853269984665640564039457584007913129639935`),
-				bigutil.MustBigIntToUint256(ethmath.MaxBig256),
+				bigutil.MaxUint256(),
 			},
 		}
 
@@ -175,3 +564,87 @@ func TestUint256UnmarshalJSON(t *testing.T) {
 		}
 	})
 }
+
+func TestUint256UnmarshalParam(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   string
+			out  bigutil.Uint256
+		}{
+			{
+				"hexadecimal",
+				"0xff",
+				bigutil.Uint64ToUint256(255),
+			},
+			{
+				"decimal",
+				"255",
+				bigutil.Uint64ToUint256(255),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var i bigutil.Uint256
+				require.Nil(t, i.UnmarshalParam(tc.in))
+
+				require.Zero(t, i.BigInt().Cmp(tc.out.BigInt()))
+			})
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name string
+			in   string
+		}{
+			{
+				"empty",
+				"",
+			},
+			{
+				"negative",
+				"-1",
+			},
+			{
+				"invalid",
+				"0xg",
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				var i bigutil.Uint256
+				require.Error(t, i.UnmarshalParam(tc.in))
+			})
+		}
+	})
+}
+
+func BenchmarkHexToUint256(b *testing.B) {
+	for range b.N {
+		if _, err := bigutil.HexToUint256("0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkUint256String(b *testing.B) {
+	i := bigutil.MaxUint256()
+
+	for range b.N {
+		_ = i.String()
+	}
+}
+
+func BenchmarkUint256UnmarshalText(b *testing.B) {
+	text := []byte("0x0000000000000000000000000000000000000000000000000000000000000001")
+
+	for range b.N {
+		var i bigutil.Uint256
+		if err := i.UnmarshalText(text); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
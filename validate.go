@@ -0,0 +1,59 @@
+package bigutil
+
+import (
+	"math/big"
+)
+
+// maxDecimal is the decimal representation of the max value of uint256.
+const maxDecimal = "115792089237316195423570985008687907853269984665640564039457584007913129639935"
+
+// IsValidUint256Hex reports whether the given string is a 0x-prefixed hex string that represents uint256 in canonical form,
+// i.e. whether HexToUint256 and SetHex accept it.
+// Leading zero digits are not allowed.
+func IsValidUint256Hex(s string) bool {
+	_, err := decodeHexBytes(s, false)
+
+	return err == nil
+}
+
+// IsValidUint256Decimal reports whether the given string is a decimal string that represents uint256 in canonical form,
+// i.e. whether UnmarshalText accepts it when StrictParsing is true.
+// Signs and leading zero digits are not allowed.
+func IsValidUint256Decimal(s string) bool {
+	return validateDecimal(s) == nil
+}
+
+// Validate returns an error if the given big.Int does not represent uint256.
+func Validate(x *big.Int) error {
+	if x == nil {
+		return errorf("must not be nil")
+	}
+	if x.Sign() < 0 {
+		return errorf("must be positive")
+	}
+	if x.BitLen() > maxBitLength {
+		return errorf("must be less than or equal to %d bits", maxBitLength)
+	}
+
+	return nil
+}
+
+// validateDecimal validates the given decimal string in canonical form.
+func validateDecimal[T ~string | ~[]byte](s T) *ParseError {
+	if len(s) == 0 {
+		return newParseError(s, 0, ReasonEmpty)
+	}
+	if len(s) > 1 && s[0] == '0' {
+		return newParseError(s, 0, ReasonLeadingZero)
+	}
+	for idx := 0; idx < len(s); idx++ {
+		if s[idx] < '0' || s[idx] > '9' {
+			return newParseError(s, idx, ReasonInvalidDigit)
+		}
+	}
+	if len(s) > len(maxDecimal) || (len(s) == len(maxDecimal) && string(s) > maxDecimal) {
+		return newParseError(s, -1, ReasonOutOfRange)
+	}
+
+	return nil
+}
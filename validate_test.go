@@ -0,0 +1,144 @@
+package bigutil_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+)
+
+func TestIsValidUint256Hex(t *testing.T) {
+	tcs := []struct {
+		name string
+		in   string
+		out  bool
+	}{
+		{
+			"min",
+			"0x0",
+			true,
+		},
+		{
+			"leading zero digits",
+			"0x0001",
+			false,
+		},
+		{
+			"max",
+			"0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+			true,
+		},
+		{
+			"empty",
+			"",
+			false,
+		},
+		{
+			"without prefix",
+			"ff",
+			false,
+		},
+		{
+			"prefix only",
+			"0x",
+			false,
+		},
+		{
+			"invalid digit",
+			"0xfg",
+			false,
+		},
+		{
+			"too large",
+			"0x10000000000000000000000000000000000000000000000000000000000000000",
+			false,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.out, bigutil.IsValidUint256Hex(tc.in))
+
+			_, err := bigutil.HexToUint256(tc.in)
+			require.Equal(t, tc.out, err == nil)
+		})
+	}
+}
+
+func TestIsValidUint256Decimal(t *testing.T) {
+	tcs := []struct {
+		name string
+		in   string
+		out  bool
+	}{
+		{
+			"min",
+			"0",
+			true,
+		},
+		{
+			"max",
+			"115792089237316195423570985008687907853269984665640564039457584007913129639935",
+			true,
+		},
+		{
+			"empty",
+			"",
+			false,
+		},
+		{
+			"sign",
+			"+1",
+			false,
+		},
+		{
+			"leading zero digits",
+			"01",
+			false,
+		},
+		{
+			"invalid digit",
+			"1a",
+			false,
+		},
+		{
+			"too large",
+			"115792089237316195423570985008687907853269984665640564039457584007913129639936",
+			false,
+		},
+		{
+			"too long",
+			"1000000000000000000000000000000000000000000000000000000000000000000000000000000",
+			false,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.out, bigutil.IsValidUint256Decimal(tc.in))
+
+			bigutil.StrictParsing = true
+			t.Cleanup(func() {
+				bigutil.StrictParsing = false
+			})
+
+			var i bigutil.Uint256
+			require.Equal(t, tc.out, i.UnmarshalText([]byte(tc.in)) == nil)
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		require.Nil(t, bigutil.Validate(big.NewInt(0)))
+		require.Nil(t, bigutil.Validate(bigutil.MaxUint256().BigInt()))
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		require.Error(t, bigutil.Validate(nil))
+		require.Error(t, bigutil.Validate(big.NewInt(-1)))
+		require.Error(t, bigutil.Validate(new(big.Int).Lsh(big.NewInt(1), 256)))
+	})
+}
@@ -0,0 +1,82 @@
+package bigutil
+
+import (
+	"io"
+	"math/big"
+)
+
+// WeightedPick returns an index of the given weights chosen with probability proportional to its weight,
+// reading randomness from r (e.g. crypto/rand.Reader).
+// The selection is exact: it uses rejection sampling over the total weight without float conversion.
+func WeightedPick(r io.Reader, weights []Uint256) (int, error) {
+	total := new(big.Int)
+	for idx := range weights {
+		total.Add(total, &weights[idx].x)
+	}
+	if total.Sign() == 0 {
+		return 0, errorf("total weight must be positive")
+	}
+
+	n, err := uniform(r, total)
+	if err != nil {
+		return 0, err
+	}
+
+	for idx := range weights {
+		if n.Cmp(&weights[idx].x) < 0 {
+			return idx, nil
+		}
+		n.Sub(n, &weights[idx].x)
+	}
+
+	// unreachable because n is less than total
+	return 0, errorf("failed to pick")
+}
+
+// WeightedAvg returns the average of the given values weighted by the given weights, rounded down.
+// The weighted sum is computed at full precision, so it never overflows or loses precision.
+// It returns an error if the lengths differ or the total weight is zero.
+func WeightedAvg(values, weights []Uint256) (Uint256, error) {
+	if len(values) != len(weights) {
+		return Uint256{}, errorf("values and weights must have the same length")
+	}
+
+	var sum, total, p big.Int
+	for idx := range values {
+		sum.Add(&sum, p.Mul(&values[idx].x, &weights[idx].x))
+		total.Add(&total, &weights[idx].x)
+	}
+	if total.Sign() == 0 {
+		return Uint256{}, errorf("total weight must be positive")
+	}
+
+	// The quotient is at most the largest value, so it always fits in 256 bits.
+	r := Uint256{}
+	r.x.Quo(&sum, &total)
+
+	return r, nil
+}
+
+// uniform returns a uniform random value in [0, n) by rejection sampling.
+func uniform(r io.Reader, n *big.Int) (*big.Int, error) {
+	limit := new(big.Int).Sub(n, big.NewInt(1))
+	bitLen := limit.BitLen()
+	if bitLen == 0 {
+		return new(big.Int), nil
+	}
+
+	b := make([]byte, (bitLen+7)/8)
+	mask := byte(1<<(uint(bitLen-1)%8+1) - 1)
+
+	x := new(big.Int)
+	for {
+		if _, err := io.ReadFull(r, b); err != nil {
+			return nil, err
+		}
+		b[0] &= mask
+
+		if x.SetBytes(b).Cmp(n) < 0 {
+			return x, nil
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package bigutil_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m0t0k1ch1-go/bigutil/v2"
+)
+
+func TestWeightedPick(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name    string
+			random  byte
+			weights []bigutil.Uint256
+			out     int
+		}{
+			{
+				"first",
+				0,
+				uint256s(1, 2, 1),
+				0,
+			},
+			{
+				"second",
+				1,
+				uint256s(1, 2, 1),
+				1,
+			},
+			{
+				"second (upper end)",
+				2,
+				uint256s(1, 2, 1),
+				1,
+			},
+			{
+				"last",
+				3,
+				uint256s(1, 2, 1),
+				2,
+			},
+			{
+				"zero weights are skipped",
+				0,
+				uint256s(0, 1, 0),
+				1,
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				idx, err := bigutil.WeightedPick(bytes.NewReader([]byte{tc.random}), tc.weights)
+				require.Nil(t, err)
+
+				require.Equal(t, tc.out, idx)
+			})
+		}
+	})
+
+	t.Run("distribution", func(t *testing.T) {
+		weights := uint256s(1, 3)
+
+		counts := make([]int, len(weights))
+		for range 4000 {
+			idx, err := bigutil.WeightedPick(rand.Reader, weights)
+			require.Nil(t, err)
+
+			counts[idx]++
+		}
+
+		require.InDelta(t, 3.0, float64(counts[1])/float64(counts[0]), 0.5)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		_, err := bigutil.WeightedPick(rand.Reader, nil)
+		require.Error(t, err)
+
+		_, err = bigutil.WeightedPick(rand.Reader, uint256s(0, 0))
+		require.Error(t, err)
+
+		_, err = bigutil.WeightedPick(bytes.NewReader(nil), uint256s(1, 1))
+		require.Error(t, err)
+	})
+}
+
+func TestWeightedAvg(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tcs := []struct {
+			name    string
+			values  []bigutil.Uint256
+			weights []bigutil.Uint256
+			out     bigutil.Uint256
+		}{
+			{
+				"equal weights",
+				uint256s(10, 20, 30),
+				uint256s(1, 1, 1),
+				bigutil.Uint64ToUint256(20),
+			},
+			{
+				"rounded down",
+				uint256s(10, 20),
+				uint256s(1, 2),
+				bigutil.Uint64ToUint256(16),
+			},
+			{
+				"zero weight",
+				uint256s(10, 1000),
+				uint256s(1, 0),
+				bigutil.Uint64ToUint256(10),
+			},
+			{
+				"full precision",
+				[]bigutil.Uint256{bigutil.MaxUint256(), bigutil.MaxUint256()},
+				[]bigutil.Uint256{bigutil.MaxUint256(), bigutil.MaxUint256()},
+				bigutil.MaxUint256(),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				out, err := bigutil.WeightedAvg(tc.values, tc.weights)
+				require.Nil(t, err)
+
+				require.Zero(t, out.BigInt().Cmp(tc.out.BigInt()))
+			})
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		tcs := []struct {
+			name    string
+			values  []bigutil.Uint256
+			weights []bigutil.Uint256
+		}{
+			{
+				"length mismatch",
+				uint256s(1, 2),
+				uint256s(1),
+			},
+			{
+				"empty",
+				nil,
+				nil,
+			},
+			{
+				"zero total weight",
+				uint256s(1, 2),
+				uint256s(0, 0),
+			},
+		}
+
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := bigutil.WeightedAvg(tc.values, tc.weights)
+				require.Error(t, err)
+			})
+		}
+	})
+}